@@ -6,11 +6,11 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"gopkg.in/leonelquinteros/gotext.v1"
 
 	"encoding/json"
 
@@ -18,6 +18,8 @@ import (
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/operations"
+	"github.com/Azure/acs-engine/pkg/statestore"
 )
 
 const (
@@ -26,12 +28,21 @@ const (
 	deployLongDescription  = "deploys an Azure Resource Manager template, parameters file and other assets for a cluster"
 )
 
+const (
+	// defaultPrincipalPropagationRetries is the number of times a role assignment is attempted
+	// while its newly created principal propagates through AAD
+	defaultPrincipalPropagationRetries = 20
+	// defaultPrincipalPropagationRetryInterval is the delay between propagation retries
+	defaultPrincipalPropagationRetryInterval = 10 * time.Second
+)
+
 type DepConf struct {
 	ApiModelPath, Location, OutDir, ResourceGroup, SubscriptionId string
 }
 
 type deployCmd struct {
 	authArgs
+	translation
 
 	apimodelPath      string
 	dnsPrefix         string
@@ -42,11 +53,22 @@ type deployCmd struct {
 	classicMode       bool
 	noPrettyPrint     bool
 	parametersOnly    bool
+	resolveSPObjectID bool
+	stateStoreURI     string
+	keyVaultID        string
+	// agentsWaitForMasters makes the agents provision only after the masters
+	agentsWaitForMasters bool
+	devMode              bool
+	// principalRetries is the number of times a role assignment is attempted while its principal
+	// propagates through AAD, principalRetryInterval the delay between the attempts
+	principalRetries       int
+	principalRetryInterval time.Duration
 
 	// derived
 	containerService *api.ContainerService
 	apiVersion       string
-	locale           *gotext.Locale
+	stateStore       statestore.Store
+	fs               statestore.Filesystem
 
 	client        armhelpers.ACSEngineClient
 	resourceGroup string
@@ -60,6 +82,13 @@ func NewDeployer(dconf *DepConf, gconf *GenConf) (*deployCmd, error) {
 		location:        dconf.Location,
 		resourceGroup:   dconf.ResourceGroup,
 		outputDirectory: dconf.OutDir,
+
+		agentsWaitForMasters: gconf.AgentsWaitForMasters,
+		devMode:              gconf.DevMode,
+		fs:                   gconf.Filesystem,
+
+		principalRetries:       defaultPrincipalPropagationRetries,
+		principalRetryInterval: defaultPrincipalPropagationRetryInterval,
 	}
 
 	authArg := authArgs{
@@ -71,6 +100,10 @@ func NewDeployer(dconf *DepConf, gconf *GenConf) (*deployCmd, error) {
 	}
 
 	dep.authArgs = authArg
+	if gconf.Translator != nil {
+		dep.translator = gconf.Translator
+		dep.locale = gconf.Translator.Locale
+	}
 
 	return dep, nil
 }
@@ -84,7 +117,10 @@ func (dc *deployCmd) Deploy() (string, string, error) {
 }
 
 func newDeployCmd() *cobra.Command {
-	dc := deployCmd{}
+	dc := deployCmd{
+		principalRetries:       defaultPrincipalPropagationRetries,
+		principalRetryInterval: defaultPrincipalPropagationRetryInterval,
+	}
 
 	deployCmd := &cobra.Command{
 		Use:   deployName,
@@ -108,6 +144,11 @@ func newDeployCmd() *cobra.Command {
 	f.StringVar(&dc.caPrivateKeyPath, "ca-private-key-path", "", "path to the CA private key to use for Kubernetes PKI assets")
 	f.StringVar(&dc.resourceGroup, "resource-group", "", "resource group to deploy to")
 	f.StringVar(&dc.location, "location", "", "location to deploy to")
+	f.BoolVar(&dc.resolveSPObjectID, "resolve-sp-object-id", false, resolveSPObjectIDFlagDescription)
+	f.StringVar(&dc.stateStoreURI, "state-store", "", stateStoreFlagDescription)
+	f.StringVar(&dc.keyVaultID, "windows-password-keyvault-id", "", "resource ID of a key vault to store the Windows admin password in, instead of the output artifacts")
+	f.BoolVar(&dc.agentsWaitForMasters, "agents-wait-for-masters", false, agentsWaitForMastersFlagDescription)
+	f.BoolVar(&dc.devMode, "dev-mode", false, devModeFlagDescription)
 
 	addAuthFlags(&dc.authArgs, f)
 
@@ -121,9 +162,7 @@ func (dc *deployCmd) validatef() error {
 	}
 
 	apiloader := &api.Apiloader{
-		Translator: &i18n.Translator{
-			Locale: dc.locale,
-		},
+		Translator: dc.getTranslator(),
 	}
 	// skip validating the model fields for now
 	dc.containerService, dc.apiVersion, err = apiloader.LoadContainerServiceFromFile(dc.apimodelPath, false, nil)
@@ -135,6 +174,12 @@ func (dc *deployCmd) validatef() error {
 		return fmt.Errorf(fmt.Sprintf("--location must be specified"))
 	}
 
+	if dc.stateStoreURI != "" {
+		if dc.stateStore, err = openStateStore(dc.stateStoreURI); err != nil {
+			return fmt.Errorf(fmt.Sprintf("error opening the state store: %s", err.Error()))
+		}
+	}
+
 	dc.client, err = dc.authArgs.getClient()
 	if err != nil {
 		return fmt.Errorf(fmt.Sprintf("failed to get client")) // TODO: cleanup
@@ -143,6 +188,11 @@ func (dc *deployCmd) validatef() error {
 	// autofillApimodel calls log.Fatal() directly and does not return errors
 	autofillApimodel(dc)
 
+	// the dev mode overrides are validated along with the autofilled values
+	if dc.devMode {
+		acsengine.SetDevModeOverrides(dc.containerService.Properties, nil)
+	}
+
 	_, _, err = revalidateApimodel(apiloader, dc.containerService, dc.apiVersion)
 	if err != nil {
 		return fmt.Errorf(fmt.Sprintf("Failed to validate the apimodel after populating values: %s", err))
@@ -219,9 +269,8 @@ func autofillApimodel(dc *deployCmd) {
 		len(dc.containerService.Properties.LinuxProfile.SSH.PublicKeys) == 0 ||
 		dc.containerService.Properties.LinuxProfile.SSH.PublicKeys[0].KeyData == "" {
 		creator := &acsengine.SSHCreator{
-			Translator: &i18n.Translator{
-				Locale: dc.locale,
-			},
+			Translator: dc.getTranslator(),
+			Fs:         dc.fs,
 		}
 		_, publicKey, err := creator.CreateSaveSSH(dc.containerService.Properties.LinuxProfile.AdminUsername, dc.outputDirectory)
 		if err != nil {
@@ -231,6 +280,27 @@ func autofillApimodel(dc *deployCmd) {
 		dc.containerService.Properties.LinuxProfile.SSH.PublicKeys = []api.PublicKey{{KeyData: publicKey}}
 	}
 
+	windowsProfile := dc.containerService.Properties.WindowsProfile
+	if dc.keyVaultID != "" && dc.containerService.Properties.HasWindows() && windowsProfile != nil {
+		if keyVaultIDFromSecretPath(windowsProfile.AdminPassword) != "" {
+			log.Fatalf("invalid configuration: the apimodel windowsProfile.adminPassword already references a key vault and --windows-password-keyvault-id was specified")
+		}
+		password := windowsProfile.AdminPassword
+		if password == "" {
+			log.Warnln("apimodel: no windowsProfile.adminPassword was specified, generating one...")
+			if password, err = acsengine.GenerateWindowsPassword(); err != nil {
+				log.Fatalf("failed to generate the Windows admin password: %q", err)
+			}
+		}
+		secretName := windowsPasswordSecretName(dc.containerService)
+		secretPath, err := operations.SetKeyVaultSecret(dc.client, log.NewEntry(log.New()), dc.keyVaultID, secretName, password)
+		if err != nil {
+			log.Fatalf("failed to store the Windows admin password in key vault: %q", err)
+		}
+		log.Infof("apimodel: windowsProfile.adminPassword is stored in key vault secret %s", secretPath)
+		windowsProfile.AdminPassword = secretPath
+	}
+
 	_, err = dc.client.EnsureResourceGroup(dc.resourceGroup, dc.location, nil)
 	if err != nil {
 		log.Fatalln(err)
@@ -254,24 +324,88 @@ func autofillApimodel(dc *deployCmd) {
 			log.Warnf("created application with applicationID (%s) and servicePrincipalObjectID (%s).", applicationID, servicePrincipalObjectID)
 
 			log.Warnln("apimodel: ServicePrincipalProfile was empty, assigning role to application...")
-			for {
-				err = dc.client.CreateRoleAssignmentSimple(dc.resourceGroup, servicePrincipalObjectID)
-				if err != nil {
-					log.Debugf("Failed to create role assignment (will retry): %q", err)
-					time.Sleep(3 * time.Second)
-					continue
-				}
-				break
+			if err = dc.assignServicePrincipalRole(servicePrincipalObjectID); err != nil {
+				log.Fatalf("apimodel invalid: failed to assign the role of the created service principal: %q", err)
 			}
 
 			dc.containerService.Properties.ServicePrincipalProfile = &api.ServicePrincipalProfile{
 				ClientID: applicationID,
 				Secret:   secret,
+				ObjectID: servicePrincipalObjectID,
+			}
+		} else if dc.resolveSPObjectID {
+			if err = resolveServicePrincipalObjectID(dc.client, dc.containerService.Properties); err != nil {
+				log.Fatalf("apimodel invalid: %s", err)
+			}
+			if spp != nil && spp.ObjectID != "" {
+				if err = dc.assignServicePrincipalRole(spp.ObjectID); err != nil {
+					log.Fatalf("apimodel invalid: failed to assign the role of service principal %s: %q", spp.ClientID, err)
+				}
 			}
 		}
 	}
 }
 
+// resolveServicePrincipalObjectID looks up and records the object ID of the service principal of
+// properties when only its client ID is known, so that the principal can be assigned its role.
+// The lookup is not retried: a principal that is not found is most likely a wrong client ID.
+func resolveServicePrincipalObjectID(client armhelpers.ACSEngineClient, properties *api.Properties) error {
+	if properties.OrchestratorProfile.KubernetesConfig != nil && properties.OrchestratorProfile.KubernetesConfig.UseManagedIdentity {
+		return nil
+	}
+	spp := properties.ServicePrincipalProfile
+	if spp == nil || spp.ClientID == "" || spp.ObjectID != "" {
+		return nil
+	}
+	log.Infof("apimodel: resolving the object ID of service principal %s...", spp.ClientID)
+	objectID, err := client.GetServicePrincipalObjectID(spp.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve the object ID of service principal %s: %s", spp.ClientID, err.Error())
+	}
+	log.Infof("apimodel: service principal %s has object ID %s", spp.ClientID, objectID)
+	spp.ObjectID = objectID
+	return nil
+}
+
+// assignServicePrincipalRole assigns the service principal objectID the Contributor role on the
+// resource group. The assignment is retried while a new principal propagates through AAD, and an
+// existing assignment is not an error, as the principal may already hold the role.
+func (dc *deployCmd) assignServicePrincipalRole(objectID string) error {
+	err := dc.retryWhilePrincipalPropagates(fmt.Sprintf("role assignment of %s", objectID), func() error {
+		return dc.client.CreateRoleAssignmentSimple(dc.resourceGroup, objectID)
+	})
+	if isRoleAssignmentExistsError(err) {
+		log.Infof("apimodel: service principal %s already has a role assignment on resource group %s", objectID, dc.resourceGroup)
+		return nil
+	}
+	return err
+}
+
+// retryWhilePrincipalPropagates calls f until it succeeds or fails with an error other than
+// PrincipalNotFound, at most dc.principalRetries times
+func (dc *deployCmd) retryWhilePrincipalPropagates(description string, f func() error) error {
+	for i := 1; ; i++ {
+		err := f()
+		if !isPrincipalNotFoundError(err) || i >= dc.principalRetries {
+			return err
+		}
+		log.Warnf("%s failed because a principal has not propagated through AAD yet, retrying in %s", description, dc.principalRetryInterval)
+		time.Sleep(dc.principalRetryInterval)
+	}
+}
+
+// isPrincipalNotFoundError returns true if ARM rejected a role assignment because its
+// principal has not yet propagated through AAD
+func isPrincipalNotFoundError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "PrincipalNotFound")
+}
+
+// isRoleAssignmentExistsError returns true if ARM rejected a role assignment because the
+// principal already holds the role
+func isRoleAssignmentExistsError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "RoleAssignmentExists")
+}
+
 func revalidateApimodel(apiloader *api.Apiloader, containerService *api.ContainerService, apiVersion string) (*api.ContainerService, string, error) {
 	// This isn't terribly elegant, but it's the easiest way to go for now w/o duplicating a bunch of code
 	rawVersionedAPIModel, err := apiloader.SerializeContainerService(containerService, apiVersion)
@@ -283,15 +417,14 @@ func revalidateApimodel(apiloader *api.Apiloader, containerService *api.Containe
 
 func (dc *deployCmd) run() (string, string, error) {
 	ctx := acsengine.Context{
-		Translator: &i18n.Translator{
-			Locale: dc.locale,
-		},
+		Translator: dc.getTranslator(),
 	}
 
 	templateGenerator, err := acsengine.InitializeTemplateGenerator(ctx, dc.classicMode)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to initialize template generator: %s", err.Error())
 	}
+	templateGenerator.AgentsWaitForMasters = dc.agentsWaitForMasters
 
 	template, parameters, certsgenerated, err := templateGenerator.GenerateTemplate(dc.containerService, acsengine.DefaultGeneratorCode)
 	if err != nil {
@@ -307,9 +440,9 @@ func (dc *deployCmd) run() (string, string, error) {
 	}
 
 	writer := &acsengine.ArtifactWriter{
-		Translator: &i18n.Translator{
-			Locale: dc.locale,
-		},
+		Translator: dc.getTranslator(),
+		Store:      dc.stateStore,
+		Fs:         dc.fs,
 	}
 	if err = writer.WriteTLSArtifacts(dc.containerService, dc.apiVersion, template, parametersFile, dc.outputDirectory, certsgenerated, dc.parametersOnly); err != nil {
 		return "", "", fmt.Errorf("error writing artifacts: %s \n", err.Error())
@@ -340,5 +473,20 @@ func (dc *deployCmd) run() (string, string, error) {
 	if err != nil {
 		return "", "", err
 	}
+
+	// the deployment metadata is only recorded in a state store, as upgrade records the
+	// upgraded apimodel, so that a deployment directory holds the generated artifacts only
+	if dc.stateStore == nil {
+		return name, parametersFile, nil
+	}
+	deployment := &statestore.Deployment{
+		SubscriptionID: dc.authArgs.SubscriptionID.String(),
+		ResourceGroup:  dc.resourceGroup,
+		Location:       dc.location,
+		DeploymentName: name,
+	}
+	if err = statestore.SaveDeployment(dc.stateStore, deployment); err != nil {
+		return "", "", fmt.Errorf("error saving the deployment metadata: %s", err.Error())
+	}
 	return name, parametersFile, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/i18n"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -94,3 +95,150 @@ func testAutodeployCredentialHandling(t *testing.T, useManagedIdentity bool, cli
 		}
 	}
 }
+
+func TestAutofillApimodelResolvesServicePrincipalObjectID(t *testing.T) {
+	apiloader := &api.Apiloader{
+		Translator: nil,
+	}
+
+	apimodel := getExampleAPIModel(false, "clientID", "clientSecret")
+	cs, ver, err := apiloader.DeserializeContainerService([]byte(apimodel), false, nil)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing the example apimodel: %s", err)
+	}
+
+	client := &armhelpers.MockACSEngineClient{}
+	deployCmd := &deployCmd{
+		apimodelPath:      "./this/is/unused.json",
+		dnsPrefix:         "dnsPrefix1",
+		outputDirectory:   "_test_output",
+		location:          "westus",
+		resolveSPObjectID: true,
+
+		containerService: cs,
+		apiVersion:       ver,
+
+		client: client,
+	}
+
+	autofillApimodel(deployCmd)
+
+	defer os.RemoveAll(deployCmd.outputDirectory)
+
+	cs, _, err = revalidateApimodel(apiloader, cs, ver)
+	if err != nil {
+		t.Fatalf("unexpected error validating apimodel after populating defaults: %s", err)
+	}
+
+	if cs.Properties.ServicePrincipalProfile.ObjectID != "sp-object-id" {
+		t.Fatalf("expected the service principal object ID to be resolved, got %q", cs.Properties.ServicePrincipalProfile.ObjectID)
+	}
+	if len(client.RoleAssignments) != 1 || client.RoleAssignments[0] != "sp-object-id" {
+		t.Fatalf("expected the resolved service principal to be assigned its role, got %v", client.RoleAssignments)
+	}
+}
+
+func TestResolveServicePrincipalObjectIDFailsFast(t *testing.T) {
+	properties := &api.Properties{
+		OrchestratorProfile:     &api.OrchestratorProfile{OrchestratorType: api.Kubernetes},
+		ServicePrincipalProfile: &api.ServicePrincipalProfile{ClientID: "wrongClientID"},
+	}
+	client := &armhelpers.MockACSEngineClient{FailGetServicePrincipalObjectID: true}
+	if err := resolveServicePrincipalObjectID(client, properties); err == nil {
+		t.Fatalf("expected an error resolving an unknown service principal")
+	}
+	if properties.ServicePrincipalProfile.ObjectID != "" {
+		t.Fatalf("expected no object ID to be recorded, got %q", properties.ServicePrincipalProfile.ObjectID)
+	}
+}
+
+func TestRetryWhilePrincipalPropagates(t *testing.T) {
+	dc := &deployCmd{principalRetries: 5}
+
+	calls := 0
+	err := dc.retryWhilePrincipalPropagates("test", func() error {
+		calls++
+		return fmt.Errorf("Code=\"PrincipalNotFound\"")
+	})
+	if err == nil || calls != dc.principalRetries {
+		t.Fatalf("expected %d attempts ending in an error, got %d attempts and error %v", dc.principalRetries, calls, err)
+	}
+
+	calls = 0
+	err = dc.retryWhilePrincipalPropagates("test", func() error {
+		calls++
+		if calls < 3 {
+			return fmt.Errorf("Code=\"PrincipalNotFound\"")
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("expected success after 3 attempts, got %d attempts and error %v", calls, err)
+	}
+
+	calls = 0
+	err = dc.retryWhilePrincipalPropagates("test", func() error {
+		calls++
+		return fmt.Errorf("Code=\"InvalidTemplate\"")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected an unrelated error not to be retried, got %d attempts and error %v", calls, err)
+	}
+}
+
+func TestAssignServicePrincipalRole(t *testing.T) {
+	client := &armhelpers.MockACSEngineClient{
+		RoleAssignmentErrors: []error{fmt.Errorf("Code=\"PrincipalNotFound\""), nil},
+	}
+	dc := &deployCmd{client: client, resourceGroup: "rg", principalRetries: 5}
+	if err := dc.assignServicePrincipalRole("sp-object-id"); err != nil {
+		t.Fatalf("unexpected error assigning the role: %s", err)
+	}
+	if len(client.RoleAssignments) != 1 || client.RoleAssignments[0] != "sp-object-id" {
+		t.Fatalf("expected the role to be assigned once the principal propagated, got %v", client.RoleAssignments)
+	}
+
+	// a principal that already holds the role under another assignment is not an error
+	client = &armhelpers.MockACSEngineClient{
+		RoleAssignmentErrors: []error{fmt.Errorf("Code=\"RoleAssignmentExists\"")},
+	}
+	dc.client = client
+	if err := dc.assignServicePrincipalRole("sp-object-id"); err != nil {
+		t.Fatalf("expected an existing role assignment to be tolerated, got %s", err)
+	}
+
+	client = &armhelpers.MockACSEngineClient{
+		RoleAssignmentErrors: []error{fmt.Errorf("Code=\"AuthorizationFailed\"")},
+	}
+	dc.client = client
+	if err := dc.assignServicePrincipalRole("sp-object-id"); err == nil {
+		t.Fatalf("expected a failed role assignment to be reported")
+	}
+}
+
+func TestIsPrincipalNotFoundError(t *testing.T) {
+	if isPrincipalNotFoundError(nil) {
+		t.Fatalf("expected a nil error not to be a PrincipalNotFound error")
+	}
+	if !isPrincipalNotFoundError(fmt.Errorf("Code=\"PrincipalNotFound\" Message=\"Principal abc does not exist in the directory\"")) {
+		t.Fatalf("expected a PrincipalNotFound error to be detected")
+	}
+	if isPrincipalNotFoundError(fmt.Errorf("Code=\"InvalidTemplate\"")) {
+		t.Fatalf("expected an unrelated error not to be a PrincipalNotFound error")
+	}
+}
+
+func TestNewDeployerSharesTheTranslator(t *testing.T) {
+	injected := &i18n.Translator{}
+	d, err := NewDeployer(&DepConf{}, &GenConf{
+		CliProfile: &api.ServicePrincipalProfile{},
+		Translator: injected,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the deployer: %s", err)
+	}
+	// the apiloader, SSH creator, template generator and artifact writer all take getTranslator()
+	if d.getTranslator() != injected {
+		t.Fatalf("expected the translator of the configuration to be used")
+	}
+}
@@ -10,11 +10,13 @@ import (
 	"encoding/json"
 	"github.com/Azure/acs-engine/pkg/acsengine"
 	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"gopkg.in/leonelquinteros/gotext.v1"
 	"strings"
+	"sync"
 )
 
 const (
@@ -24,6 +26,9 @@ const (
 )
 
 type generateCmd struct {
+	authArgs
+	translation
+
 	apimodelPath      string
 	outputDirectory   string // can be auto-determined from clusterDefinition
 	caCertificatePath string
@@ -31,11 +36,23 @@ type generateCmd struct {
 	classicMode       bool
 	noPrettyPrint     bool
 	parametersOnly    bool
+	stateStoreURI     string
+	// agentsWaitForMasters makes the agents provision only after the masters
+	agentsWaitForMasters bool
+	printSummary         bool
+	writeSummary         bool
+	devMode              bool
+	resolveSPObjectID    bool
 
 	// derived
 	containerService *api.ContainerService
 	apiVersion       string
-	locale           *gotext.Locale
+	metrics          acsengine.MetricsRecorder
+	stateStore       statestore.Store
+	fs               statestore.Filesystem
+	logger           *log.Entry
+	warnings         *warningCollector
+	client           armhelpers.ACSEngineClient
 }
 
 type Model struct {
@@ -46,23 +63,47 @@ type Model struct {
 type GenConf struct {
 	ApiConfPath, OutDir, Name, SSHKey string
 	CliProfile                        *api.ServicePrincipalProfile
+	// Metrics receives the generation metrics, if set
+	Metrics acsengine.MetricsRecorder
+	// StateStore receives the generated artifacts instead of OutDir, if set
+	StateStore statestore.Store
+	// Filesystem is the filesystem of OutDir, and of the deployer's OutDir, if set, e.g. to
+	// capture the artifacts in memory
+	Filesystem statestore.Filesystem
+	// AgentsWaitForMasters makes the agents provision only after the masters
+	AgentsWaitForMasters bool
+	// WriteSummary writes the generation summary to summary.json with the other artifacts
+	WriteSummary bool
+	// DevMode shrinks the cluster to the smallest viable configuration
+	DevMode bool
+	// Translator localizes the error messages of the loading, generation and writing of
+	// the cluster, if set
+	Translator *i18n.Translator
+	// Client resolves the object ID of the service principal in AAD, if set, so that the
+	// template assigns the principal its role
+	Client armhelpers.ACSEngineClient
 }
 
 // TODO we should not have a config file, we should take it from somewhere
 func NewGenerator(conf *GenConf) (*generateCmd, error) {
+	metrics := acsengine.MetricsOrNoop(conf.Metrics)
+
 	f, err := os.Open(conf.ApiConfPath)
 	if err != nil {
+		metrics.IncGenerationErrors(acsengine.GenerationStageLoad)
 		return nil, err
 	}
 	defer f.Close()
 
 	data, err := ioutil.ReadAll(f)
 	if err != nil {
+		metrics.IncGenerationErrors(acsengine.GenerationStageLoad)
 		return nil, err
 	}
 
 	model := Model{}
 	if err := json.Unmarshal(data, &model); err != nil {
+		metrics.IncGenerationErrors(acsengine.GenerationStageLoad)
 		return nil, err
 	}
 
@@ -71,10 +112,26 @@ func NewGenerator(conf *GenConf) (*generateCmd, error) {
 	model.Props.LinuxProfile.SSH.PublicKeys = []api.PublicKey{{KeyData: conf.SSHKey}}
 
 	gen := generateCmd{}
+	if conf.WriteSummary {
+		gen.writeSummary = true
+		gen.logger, gen.warnings = newGenerationLogger()
+	}
 	gen.apimodelPath = conf.ApiConfPath
 	gen.outputDirectory = conf.OutDir
+	gen.metrics = metrics
+	gen.stateStore = conf.StateStore
+	gen.fs = conf.Filesystem
+	gen.agentsWaitForMasters = conf.AgentsWaitForMasters
+	gen.devMode = conf.DevMode
+	gen.client = conf.Client
+	gen.resolveSPObjectID = conf.Client != nil
+	if conf.Translator != nil {
+		gen.translator = conf.Translator
+		gen.locale = conf.Translator.Locale
+	}
 
 	if err := gen.getContService(&model); err != nil {
+		metrics.IncGenerationErrors(acsengine.GenerationStageLoad)
 		return nil, err
 	}
 
@@ -89,6 +146,9 @@ func newGenerateCmd() *cobra.Command {
 		Short: generateShortDescription,
 		Long:  generateLongDescription,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if gc.printSummary || gc.writeSummary {
+				gc.logger, gc.warnings = newGenerationLogger()
+			}
 			if err := gc.validate(cmd, args); err != nil {
 				log.Fatalf(fmt.Sprintf("error validating generateCmd: %s", err.Error()))
 			}
@@ -104,6 +164,14 @@ func newGenerateCmd() *cobra.Command {
 	f.BoolVar(&gc.classicMode, "classic-mode", false, "enable classic parameters and outputs")
 	f.BoolVar(&gc.noPrettyPrint, "no-pretty-print", false, "skip pretty printing the output")
 	f.BoolVar(&gc.parametersOnly, "parameters-only", false, "only output parameters files")
+	f.StringVar(&gc.stateStoreURI, "state-store", "", stateStoreFlagDescription)
+	f.BoolVar(&gc.agentsWaitForMasters, "agents-wait-for-masters", false, agentsWaitForMastersFlagDescription)
+	f.BoolVar(&gc.devMode, "dev-mode", false, devModeFlagDescription)
+	f.BoolVar(&gc.printSummary, "print-summary", false, "print a JSON summary of the generated cluster to stdout")
+	f.BoolVar(&gc.writeSummary, "write-summary", false, "write a JSON summary of the generated cluster to summary.json with the other artifacts")
+	f.BoolVar(&gc.resolveSPObjectID, "resolve-sp-object-id", false, resolveSPObjectIDFlagDescription)
+
+	addAuthFlags(&gc.authArgs, f)
 
 	return generateCmd
 }
@@ -111,9 +179,7 @@ func newGenerateCmd() *cobra.Command {
 func (gc *generateCmd) getContService(m *Model) error {
 	var err error
 	apiloader := &api.Apiloader{
-		Translator: &i18n.Translator{
-			Locale: gc.locale,
-		},
+		Translator: gc.getTranslator(),
 	}
 
 	contents, err := json.Marshal(m)
@@ -124,11 +190,19 @@ func (gc *generateCmd) getContService(m *Model) error {
 	scont := strings.Replace(string(contents), "\"subnet\":\"\",", "", -1)
 
 	//gc.containerService, gc.apiVersion, err = apiloader.LoadContainerServiceFromFile(gc.apimodelPath, true, nil)
-	gc.containerService, gc.apiVersion, err = apiloader.DeserializeContainerService([]byte(scont), true, nil)
+	gc.containerService, gc.apiVersion, err = apiloader.DeserializeContainerService([]byte(scont), !gc.devMode, nil)
 
 	if err != nil {
 		return fmt.Errorf(fmt.Sprintf("error parsing the api model: %s", err.Error()))
 	}
+
+	// the dev mode overrides are applied before the apimodel is validated
+	if gc.devMode {
+		acsengine.SetDevModeOverrides(gc.containerService.Properties, gc.logger)
+		if _, _, err = revalidateApimodel(apiloader, gc.containerService, gc.apiVersion); err != nil {
+			return fmt.Errorf(fmt.Sprintf("error validating the api model: %s", err.Error()))
+		}
+	}
 	return nil
 }
 
@@ -141,6 +215,12 @@ func (gc *generateCmd) validatef() error {
 		return fmt.Errorf(fmt.Sprintf("specified api model does not exist (%s)", gc.apimodelPath))
 	}
 
+	if gc.stateStoreURI != "" {
+		if gc.stateStore, err = openStateStore(gc.stateStoreURI); err != nil {
+			return fmt.Errorf(fmt.Sprintf("error opening the state store: %s", err.Error()))
+		}
+	}
+
 	if gc.outputDirectory == "" {
 		if gc.containerService.Properties.MasterProfile != nil {
 			gc.outputDirectory = path.Join("_output", gc.containerService.Properties.MasterProfile.DNSPrefix)
@@ -154,6 +234,11 @@ func (gc *generateCmd) validatef() error {
 	if (gc.caCertificatePath != "" && gc.caPrivateKeyPath == "") || (gc.caCertificatePath == "" && gc.caPrivateKeyPath != "") {
 		return errors.New("--ca-certificate-path and --ca-private-key-path must be specified together")
 	}
+	if gc.resolveSPObjectID && gc.client == nil {
+		if gc.client, err = gc.authArgs.getClient(); err != nil {
+			return fmt.Errorf(fmt.Sprintf("failed to get client: %s", err.Error()))
+		}
+	}
 	if gc.caCertificatePath != "" {
 		if caCertificateBytes, err = ioutil.ReadFile(gc.caCertificatePath); err != nil {
 			return fmt.Errorf(fmt.Sprintf("failed to read CA certificate file: %s", err.Error()))
@@ -178,6 +263,9 @@ func (gc *generateCmd) validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf(fmt.Sprintf("error loading translation files: %s", err.Error()))
 	}
+	gc.translator = &i18n.Translator{
+		Locale: gc.locale,
+	}
 
 	if gc.apimodelPath == "" {
 		if len(args) == 1 {
@@ -200,15 +288,25 @@ func (gc *generateCmd) validate(cmd *cobra.Command, args []string) error {
 func (gc *generateCmd) run() error {
 	log.Infoln(fmt.Sprintf("Generating assets into %s...", gc.outputDirectory))
 
+	gc.metrics = acsengine.MetricsOrNoop(gc.metrics)
+
 	ctx := acsengine.Context{
-		Translator: &i18n.Translator{
-			Locale: gc.locale,
-		},
+		Translator: gc.getTranslator(),
+		Metrics:    gc.metrics,
+		Logger:     gc.logger,
 	}
 	templateGenerator, err := acsengine.InitializeTemplateGenerator(ctx, gc.classicMode)
 	if err != nil {
 		log.Fatalln("failed to initialize template generator: %s", err.Error())
 	}
+	templateGenerator.AgentsWaitForMasters = gc.agentsWaitForMasters
+
+	if gc.resolveSPObjectID {
+		if err = resolveServicePrincipalObjectID(gc.client, gc.containerService.Properties); err != nil {
+			gc.metrics.IncGenerationErrors(acsengine.GenerationStageLoad)
+			log.Fatalf("error resolving the service principal: %s", err.Error())
+		}
+	}
 
 	template, parameters, certsGenerated, err := templateGenerator.GenerateTemplate(gc.containerService, acsengine.DefaultGeneratorCode)
 	if err != nil {
@@ -218,22 +316,46 @@ func (gc *generateCmd) run() error {
 
 	if !gc.noPrettyPrint {
 		if template, err = acsengine.PrettyPrintArmTemplate(template); err != nil {
+			gc.metrics.IncGenerationErrors(acsengine.GenerationStagePrettyPrint)
 			log.Fatalf("error pretty printing template: %s \n", err.Error())
 		}
 		if parameters, err = acsengine.BuildAzureParametersFile(parameters); err != nil {
+			gc.metrics.IncGenerationErrors(acsengine.GenerationStagePrettyPrint)
 			log.Fatalf("error pretty printing template parameters: %s \n", err.Error())
 		}
 	}
 
 	writer := &acsengine.ArtifactWriter{
-		Translator: &i18n.Translator{
-			Locale: gc.locale,
-		},
+		Translator: gc.getTranslator(),
+		Store:      gc.stateStore,
+		Fs:         gc.fs,
 	}
 	if err = writer.WriteTLSArtifacts(gc.containerService, gc.apiVersion, template, parameters, gc.outputDirectory, certsGenerated, gc.parametersOnly); err != nil {
+		gc.metrics.IncGenerationErrors(acsengine.GenerationStageWrite)
 		log.Fatalf("error writing artifacts: %s \n", err.Error())
 	}
 
+	if gc.printSummary || gc.writeSummary {
+		summary := acsengine.NewGenerationSummary(gc.containerService)
+		summary.Artifacts = writer.Artifacts()
+		if gc.warnings != nil {
+			summary.Warnings = gc.warnings.Warnings()
+		}
+		if gc.writeSummary {
+			if err = writer.WriteSummary(gc.containerService, summary, gc.outputDirectory); err != nil {
+				gc.metrics.IncGenerationErrors(acsengine.GenerationStageWrite)
+				log.Fatalf("error writing the generation summary: %s \n", err.Error())
+			}
+		}
+		if gc.printSummary {
+			b, err := summary.JSON()
+			if err != nil {
+				log.Fatalf("error printing the generation summary: %s \n", err.Error())
+			}
+			fmt.Println(string(b))
+		}
+	}
+
 	return nil
 }
 
@@ -243,3 +365,47 @@ func (gc *generateCmd) Generate() error {
 	}
 	return gc.run()
 }
+
+// warningCollector is a logrus hook that records the warnings logged during a generation,
+// so that they can be reported in the generation summary
+type warningCollector struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+// newGenerationLogger returns a logger for a single generation, writing where and how the
+// standard logger does, and the collector of the warnings logged to it. The collector is
+// only hooked to this logger, so it does not record the warnings of other generations, and
+// goes away with the generation.
+func newGenerationLogger() (*log.Entry, *warningCollector) {
+	std := log.StandardLogger()
+	logger := &log.Logger{
+		Out:       std.Out,
+		Formatter: std.Formatter,
+		Hooks:     make(log.LevelHooks),
+		Level:     std.Level,
+	}
+	c := &warningCollector{warnings: []string{}}
+	logger.AddHook(c)
+	return log.NewEntry(logger), c
+}
+
+// Levels implements logrus.Hook
+func (c *warningCollector) Levels() []log.Level {
+	return []log.Level{log.WarnLevel}
+}
+
+// Fire implements logrus.Hook
+func (c *warningCollector) Fire(entry *log.Entry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warnings = append(c.warnings, entry.Message)
+	return nil
+}
+
+// Warnings returns the warnings recorded so far
+func (c *warningCollector) Warnings() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.warnings...)
+}
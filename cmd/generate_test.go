@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/i18n"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -37,3 +42,72 @@ func TestGenerateCmdValidate(t *testing.T) {
 	}
 
 }
+
+func TestNewGenerationLogger(t *testing.T) {
+	out := log.StandardLogger().Out
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(out)
+
+	logger1, warnings1 := newGenerationLogger()
+	logger2, warnings2 := newGenerationLogger()
+	logger1.Warnf("first generation")
+	logger2.Warnf("second generation")
+	logger2.Infof("not a warning")
+	log.Warnf("not from a generation")
+
+	if w := warnings1.Warnings(); len(w) != 1 || w[0] != "first generation" {
+		t.Errorf("expected only the warnings of the first generation, got %v", w)
+	}
+	if w := warnings2.Warnings(); len(w) != 1 || w[0] != "second generation" {
+		t.Errorf("expected only the warnings of the second generation, got %v", w)
+	}
+}
+
+func TestGetContServiceAppliesDevModeBeforeValidation(t *testing.T) {
+	// 2 masters are not a valid count, dev mode shrinks them to 1
+	model := `{
+  "apiVersion": "vlabs",
+  "properties": {
+    "orchestratorProfile": { "orchestratorType": "Kubernetes" },
+    "masterProfile": { "count": 2, "dnsPrefix": "mycluster", "vmSize": "Standard_D4_v2" },
+    "agentPoolProfiles": [ { "name": "agentpool1", "count": 3, "vmSize": "Standard_D4_v2", "availabilityProfile": "AvailabilitySet" } ],
+    "linuxProfile": { "adminUsername": "azureuser", "ssh": { "publicKeys": [ { "keyData": "ssh-rsa AAAA" } ] } },
+    "servicePrincipalProfile": { "clientId": "clientID", "secret": "clientSecret" }
+  }
+}`
+	m := Model{}
+	if err := json.Unmarshal([]byte(model), &m); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	g := &generateCmd{}
+	if err := g.getContService(&m); err == nil {
+		t.Fatalf("expected the master count to be rejected")
+	}
+
+	g = &generateCmd{devMode: true}
+	if err := g.getContService(&m); err != nil {
+		t.Fatalf("unexpected error loading the api model in dev mode: %s", err)
+	}
+	if g.containerService.Properties.MasterProfile.Count != 1 || g.containerService.Properties.AgentPoolProfiles[0].Count != 1 {
+		t.Errorf("expected the dev mode overrides to be applied")
+	}
+}
+
+func TestNewGeneratorSharesTheTranslator(t *testing.T) {
+	injected := &i18n.Translator{}
+	g, err := NewGenerator(&GenConf{
+		ApiConfPath: "../pkg/acsengine/testdata/simple/kubernetes.json",
+		Name:        "mycluster",
+		SSHKey:      "ssh-rsa AAAA",
+		CliProfile:  &api.ServicePrincipalProfile{ClientID: "clientID", Secret: "clientSecret"},
+		Translator:  injected,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the generator: %s", err)
+	}
+	// the apiloader, template generator and artifact writer all take getTranslator()
+	if g.getTranslator() != injected {
+		t.Fatalf("expected the translator of the configuration to be used")
+	}
+}
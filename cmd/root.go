@@ -1,13 +1,20 @@
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+	"gopkg.in/leonelquinteros/gotext.v1"
 )
 
 const (
@@ -16,10 +23,55 @@ const (
 	rootLongDescription  = "ACS-Engine deploys and manages Kubernetes, Swarm Mode, and DC/OS clusters in Azure"
 )
 
+// stateStoreFlagDescription documents the --state-store flag shared by the commands that persist cluster state
+const stateStoreFlagDescription = "where to persist the cluster state instead of the output directory: a local directory, or azblob://<account>/<container>[/<prefix>] with the account key in $AZURE_STORAGE_ACCOUNT_KEY"
+
+// agentsWaitForMastersFlagDescription documents the --agents-wait-for-masters flag shared by the commands that generate templates
+const agentsWaitForMastersFlagDescription = "make the Kubernetes agents start provisioning only after every master has finished provisioning"
+
+// devModeFlagDescription documents the --dev-mode flag shared by the commands that generate templates
+const devModeFlagDescription = "shrink the cluster to a single master and one agent per pool of the smallest viable size, for throwaway test clusters"
+
+// resolveSPObjectIDFlagDescription documents the --resolve-sp-object-id flag shared by the commands that generate templates
+const resolveSPObjectIDFlagDescription = "look up the service principal object ID in AAD when only the client ID is supplied, and record it in the apimodel; deploy also assigns the principal the Contributor role on the resource group"
+
 var (
 	debug bool
 )
 
+// openStateStore opens the state store given to --state-store. The state of an in memory
+// store would be lost when the command exits, so it is only available to library callers.
+func openStateStore(uri string) (statestore.Store, error) {
+	if strings.HasPrefix(uri, statestore.MemoryScheme) {
+		return nil, fmt.Errorf("%s state stores are lost when the command exits and cannot be used with --state-store", statestore.MemoryScheme)
+	}
+	return statestore.Open(uri)
+}
+
+// loadNameSuffix reads the name suffix of a generated cluster from its template, to identify
+// the resources in the resource group that belong to the cluster
+func loadNameSuffix(store statestore.Store) (string, error) {
+	contents, err := store.Load(statestore.TemplateKey)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %s", statestore.TemplateKey, err.Error())
+	}
+
+	var template struct {
+		Parameters struct {
+			NameSuffix struct {
+				DefaultValue string `json:"defaultValue"`
+			} `json:"nameSuffix"`
+		} `json:"parameters"`
+	}
+	if err = json.Unmarshal(contents, &template); err != nil {
+		return "", fmt.Errorf("error parsing %s: %s", statestore.TemplateKey, err.Error())
+	}
+	if template.Parameters.NameSuffix.DefaultValue == "" {
+		return "", fmt.Errorf("%s has no nameSuffix parameter", statestore.TemplateKey)
+	}
+	return template.Parameters.NameSuffix.DefaultValue, nil
+}
+
 // NewRootCmd returns the root command for ACS-Engine.
 func NewRootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
@@ -41,6 +93,7 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.AddCommand(newDeployCmd())
 	rootCmd.AddCommand(newOrchestratorsCmd())
 	rootCmd.AddCommand(newUpgradeCmd())
+	rootCmd.AddCommand(newRotateWindowsPasswordCmd())
 
 	return rootCmd
 }
@@ -59,6 +112,22 @@ type authArgs struct {
 	language        string
 }
 
+// translation holds the locale of a command and the translator shared by all of its steps
+type translation struct {
+	locale     *gotext.Locale
+	translator *i18n.Translator
+}
+
+// getTranslator returns the translator of the command, creating it for its locale on first use
+func (t *translation) getTranslator() *i18n.Translator {
+	if t.translator == nil {
+		t.translator = &i18n.Translator{
+			Locale: t.locale,
+		}
+	}
+	return t.translator
+}
+
 func addAuthFlags(authArgs *authArgs, f *flag.FlagSet) {
 	f.StringVar(&authArgs.RawAzureEnvironment, "azure-env", "AzurePublicCloud", "the target Azure cloud")
 	f.StringVar(&authArgs.rawSubscriptionID, "subscription-id", "", "azure subscription id")
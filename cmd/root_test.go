@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/i18n"
+)
+
+func TestOpenStateStore(t *testing.T) {
+	if _, err := openStateStore("memory://"); err == nil {
+		t.Fatalf("expected an in memory state store to be rejected")
+	}
+	if _, err := openStateStore("_output/mycluster"); err != nil {
+		t.Fatalf("unexpected error opening a local state store: %s", err)
+	}
+}
+
+func TestTranslationGetTranslator(t *testing.T) {
+	tr := &translation{}
+	translator := tr.getTranslator()
+	if translator == nil || translator.Locale != nil {
+		t.Fatalf("expected a translator without a locale, got %v", translator)
+	}
+	if tr.getTranslator() != translator {
+		t.Fatalf("expected the same translator to be shared")
+	}
+
+	injected := &i18n.Translator{}
+	tr = &translation{translator: injected}
+	if tr.getTranslator() != injected {
+		t.Fatalf("expected the injected translator to be used")
+	}
+}
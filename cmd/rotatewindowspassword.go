@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/operations"
+	"github.com/Azure/acs-engine/pkg/statestore"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	rotateWindowsPasswordName             = "rotate-windows-password"
+	rotateWindowsPasswordShortDescription = "rotates the admin password of the Windows nodes of an existing cluster"
+	rotateWindowsPasswordLongDescription  = "generates a new Windows admin password, resets it on every Windows VM and VMSS of an existing cluster, and records it in the cluster state"
+)
+
+type rotateWindowsPasswordCmd struct {
+	authArgs
+	translation
+
+	// user input
+	resourceGroupName   string
+	deploymentDirectory string
+	stateStoreURI       string
+	keyVaultID          string
+
+	// derived
+	containerService *api.ContainerService
+	apiVersion       string
+	client           armhelpers.ACSEngineClient
+	stateStore       statestore.Store
+	nameSuffix       string
+	logger           *log.Entry
+}
+
+func newRotateWindowsPasswordCmd() *cobra.Command {
+	rc := rotateWindowsPasswordCmd{
+		logger: log.NewEntry(log.New()),
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   rotateWindowsPasswordName,
+		Short: rotateWindowsPasswordShortDescription,
+		Long:  rotateWindowsPasswordLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return rc.run(cmd, args)
+		},
+	}
+
+	f := rotateCmd.Flags()
+	f.StringVar(&rc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
+	f.StringVar(&rc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
+	f.StringVar(&rc.stateStoreURI, "state-store", "", "the state store the cluster was generated into, instead of --deployment-dir: a local directory, or azblob://<account>/<container>[/<prefix>] with the account key in $AZURE_STORAGE_ACCOUNT_KEY")
+	f.StringVar(&rc.keyVaultID, "keyvault-id", "", "resource ID of a key vault to store the new password in (defaults to the vault the apimodel already references, if any)")
+	addAuthFlags(&rc.authArgs, f)
+
+	return rotateCmd
+}
+
+func (rc *rotateWindowsPasswordCmd) validate(cmd *cobra.Command, args []string) {
+	var err error
+
+	rc.locale, err = i18n.LoadTranslations()
+	if err != nil {
+		log.Fatalf("error loading translation files: %s", err.Error())
+	}
+
+	if rc.resourceGroupName == "" {
+		cmd.Usage()
+		log.Fatal("--resource-group must be specified")
+	}
+
+	if rc.deploymentDirectory == "" && rc.stateStoreURI == "" {
+		cmd.Usage()
+		log.Fatal("--deployment-dir or --state-store must be specified")
+	}
+	if rc.stateStoreURI != "" {
+		if rc.stateStore, err = openStateStore(rc.stateStoreURI); err != nil {
+			log.Fatalf("error opening the state store: %s", err.Error())
+		}
+	} else {
+		rc.stateStore = statestore.NewLocalStore(rc.deploymentDirectory)
+	}
+
+	if err = rc.loadCluster(); err != nil {
+		log.Fatalf("error loading the cluster: %s", err.Error())
+	}
+
+	if rc.client, err = rc.authArgs.getClient(); err != nil {
+		log.Fatalf("failed to get client: %s", err.Error())
+	}
+}
+
+// loadCluster reads the apimodel and the name suffix of the cluster from the state store
+func (rc *rotateWindowsPasswordCmd) loadCluster() error {
+	apiModel, err := rc.stateStore.Load(statestore.APIModelKey)
+	if err == statestore.ErrNotFound {
+		return fmt.Errorf("specified api model does not exist (%s)", statestore.APIModelKey)
+	} else if err != nil {
+		return fmt.Errorf("error reading the api model: %s", err.Error())
+	}
+
+	apiloader := &api.Apiloader{
+		Translator: rc.getTranslator(),
+	}
+	rc.containerService, rc.apiVersion, err = apiloader.DeserializeContainerService(apiModel, false, nil)
+	if err != nil {
+		return fmt.Errorf("error parsing the api model: %s", err.Error())
+	}
+	if !rc.containerService.Properties.HasWindows() || rc.containerService.Properties.WindowsProfile == nil {
+		return fmt.Errorf("the cluster has no Windows agent pools")
+	}
+
+	if rc.keyVaultID == "" {
+		rc.keyVaultID = keyVaultIDFromSecretPath(rc.containerService.Properties.WindowsProfile.AdminPassword)
+	}
+
+	// the name suffix identifies the machines in the resource group that belong to this cluster
+	if rc.nameSuffix, err = loadNameSuffix(rc.stateStore); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (rc *rotateWindowsPasswordCmd) run(cmd *cobra.Command, args []string) error {
+	rc.validate(cmd, args)
+
+	if err := rc.rotate(); err != nil {
+		log.Fatalf("error rotating the Windows admin password: %s", err.Error())
+	}
+	log.Infoln("rotated the Windows admin password")
+	return nil
+}
+
+// rotate generates the new password, records it in the cluster state and resets it on the
+// Windows machines of the cluster
+func (rc *rotateWindowsPasswordCmd) rotate() error {
+	windowsProfile := rc.containerService.Properties.WindowsProfile
+
+	password, err := acsengine.GenerateWindowsPassword()
+	if err != nil {
+		return fmt.Errorf("error generating the password: %s", err.Error())
+	}
+
+	// store the password before resetting it, so that it is never lost
+	recorded := password
+	if rc.keyVaultID != "" {
+		secretName := windowsPasswordSecretName(rc.containerService)
+		if recorded, err = operations.SetKeyVaultSecret(rc.client, rc.logger, rc.keyVaultID, secretName, password); err != nil {
+			return fmt.Errorf("error storing the password in key vault: %s", err.Error())
+		}
+	}
+	windowsProfile.AdminPassword = recorded
+
+	apiloader := &api.Apiloader{
+		Translator: rc.getTranslator(),
+	}
+	apiModel, err := apiloader.SerializeContainerService(rc.containerService, rc.apiVersion)
+	if err != nil {
+		return fmt.Errorf("error serializing the api model: %s", err.Error())
+	}
+	if err = rc.stateStore.Save(statestore.APIModelKey, apiModel); err != nil {
+		return fmt.Errorf("error saving the api model: %s", err.Error())
+	}
+	if err = rc.updateParameters(recorded); err != nil {
+		return fmt.Errorf("error saving the template parameters: %s", err.Error())
+	}
+
+	poolNames := []string{}
+	for _, pool := range rc.containerService.Properties.AgentPoolProfiles {
+		if pool.IsWindows() {
+			poolNames = append(poolNames, pool.Name)
+		}
+	}
+	return operations.RotateWindowsPassword(rc.client, rc.logger, rc.resourceGroupName, rc.nameSuffix, poolNames, windowsProfile.AdminUsername, password)
+}
+
+// updateParameters records the new password in the template parameters, if they were generated
+func (rc *rotateWindowsPasswordCmd) updateParameters(password string) error {
+	contents, err := rc.stateStore.Load(statestore.ParametersKey)
+	if err == statestore.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var parametersFile map[string]interface{}
+	if err = json.Unmarshal(contents, &parametersFile); err != nil {
+		return err
+	}
+	parameters, ok := parametersFile["parameters"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s has no parameters", statestore.ParametersKey)
+	}
+
+	value := map[string]interface{}{"value": password}
+	if ref := acsengine.GetKeyVaultRef(password); ref != nil {
+		value = map[string]interface{}{"reference": ref}
+	}
+	parameters["windowsAdminPassword"] = value
+
+	if contents, err = json.MarshalIndent(parametersFile, "", "  "); err != nil {
+		return err
+	}
+	return rc.stateStore.Save(statestore.ParametersKey, contents)
+}
+
+// windowsPasswordSecretName is the name of the key vault secret holding the Windows admin password of a cluster
+func windowsPasswordSecretName(cs *api.ContainerService) string {
+	return fmt.Sprintf("%s-windows-admin-password", cs.Properties.MasterProfile.DNSPrefix)
+}
+
+// keyVaultIDFromSecretPath returns the vault resource ID of an apimodel key vault secret path,
+// or "" if the value is not a secret path
+func keyVaultIDFromSecretPath(value string) string {
+	if ref := acsengine.GetKeyVaultRef(value); ref != nil {
+		return ref.KeyVault.ID
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/statestore"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	log "github.com/sirupsen/logrus"
+)
+
+const rotateWindowsPasswordAPIModel = `{
+  "apiVersion": "vlabs",
+  "properties": {
+    "orchestratorProfile": { "orchestratorType": "Kubernetes" },
+    "masterProfile": { "count": 1, "dnsPrefix": "mycluster", "vmSize": "Standard_D2_v2" },
+    "agentPoolProfiles": [ { "name": "windowspool2", "count": 2, "vmSize": "Standard_D2_v2", "availabilityProfile": "AvailabilitySet", "osType": "Windows" } ],
+    "windowsProfile": { "adminUsername": "azureuser", "adminPassword": "replacepassword1234$" },
+    "linuxProfile": { "adminUsername": "azureuser", "ssh": { "publicKeys": [ { "keyData": "ssh-rsa AAAA" } ] } },
+    "servicePrincipalProfile": { "clientId": "clientID", "secret": "clientSecret" }
+  }
+}
+`
+
+const rotateWindowsPasswordTemplate = `{ "parameters": { "nameSuffix": { "type": "string", "defaultValue": "12345678" } } }`
+
+func windowsVM(name, poolName, resourceNameSuffix string) compute.VirtualMachine {
+	location := "westus"
+	tags := map[string]*string{
+		"poolName":           &poolName,
+		"resourceNameSuffix": &resourceNameSuffix,
+	}
+	return compute.VirtualMachine{
+		Name:     &name,
+		Location: &location,
+		Tags:     &tags,
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			StorageProfile: &compute.StorageProfile{
+				OsDisk: &compute.OSDisk{OsType: compute.Windows},
+			},
+		},
+	}
+}
+
+func TestRotateWindowsPasswordOnlyChangesTheCluster(t *testing.T) {
+	store := statestore.NewMemoryStore()
+	if err := store.Save(statestore.APIModelKey, []byte(rotateWindowsPasswordAPIModel)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := store.Save(statestore.TemplateKey, []byte(rotateWindowsPasswordTemplate)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the resource group also holds the Windows nodes of another cluster, whose suffix is 87654321
+	client := &armhelpers.MockACSEngineClient{
+		VirtualMachines: &[]compute.VirtualMachine{
+			windowsVM("12345k8s9000", "windowspool2", "12345"),
+			windowsVM("12345k8s9001", "windowspool2", "12345"),
+			windowsVM("87654k8s9000", "windowspool2", "87654"),
+		},
+	}
+	rc := &rotateWindowsPasswordCmd{
+		resourceGroupName: "rg",
+		stateStore:        store,
+		client:            client,
+		logger:            log.NewEntry(log.New()),
+	}
+	if err := rc.loadCluster(); err != nil {
+		t.Fatalf("unexpected error loading the cluster: %s", err)
+	}
+	if rc.nameSuffix != "12345678" {
+		t.Fatalf("unexpected name suffix %s", rc.nameSuffix)
+	}
+	if err := rc.rotate(); err != nil {
+		t.Fatalf("unexpected error rotating the password: %s", err)
+	}
+
+	if len(client.DeployedTemplates) != 1 {
+		t.Fatalf("expected 1 deployment, got %d", len(client.DeployedTemplates))
+	}
+	resources := client.DeployedTemplates[0]["resources"].([]interface{})
+	names := []string{}
+	for _, r := range resources {
+		names = append(names, r.(map[string]interface{})["name"].(string))
+	}
+	if len(names) != 2 || names[0] != "12345k8s9000/enablevmaccess" || names[1] != "12345k8s9001/enablevmaccess" {
+		t.Errorf("expected only the machines of the cluster to be updated, got %v", names)
+	}
+	if rc.containerService.Properties.WindowsProfile.AdminPassword == "replacepassword1234$" {
+		t.Errorf("expected the new password to be recorded in the apimodel")
+	}
+}
+
+func TestUpdateParametersWithVersionedSecret(t *testing.T) {
+	store := statestore.NewMemoryStore()
+	if err := store.Save(statestore.ParametersKey, []byte(`{ "parameters": { "windowsAdminPassword": { "value": "replacepassword1234$" } } }`)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	rc := &rotateWindowsPasswordCmd{stateStore: store}
+
+	vaultID := "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.KeyVault/vaults/KV_NAME"
+	secretPath := vaultID + "/secrets/mycluster-windows-admin-password/0123456789abcdef"
+	if id := keyVaultIDFromSecretPath(secretPath); id != vaultID {
+		t.Fatalf("expected the vault ID %s, got %s", vaultID, id)
+	}
+	if err := rc.updateParameters(secretPath); err != nil {
+		t.Fatalf("unexpected error updating the parameters: %s", err)
+	}
+
+	b, err := store.Load(statestore.ParametersKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	parametersFile := struct {
+		Parameters map[string]struct {
+			Reference *acsengine.KeyVaultRef `json:"reference"`
+		} `json:"parameters"`
+	}{}
+	if err = json.Unmarshal(b, &parametersFile); err != nil {
+		t.Fatalf("unexpected error parsing the parameters: %s", err)
+	}
+	ref := parametersFile.Parameters["windowsAdminPassword"].Reference
+	if ref == nil || ref.KeyVault.ID != vaultID || ref.SecretName != "mycluster-windows-admin-password" || ref.SecretVersion != "0123456789abcdef" {
+		t.Errorf("unexpected key vault reference %+v", ref)
+	}
+}
@@ -1,18 +1,15 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"os"
-	"path"
 
 	"github.com/Azure/acs-engine/pkg/acsengine"
 	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
 	"github.com/Azure/acs-engine/pkg/i18n"
 	"github.com/Azure/acs-engine/pkg/operations/kubernetesupgrade"
-	"gopkg.in/leonelquinteros/gotext.v1"
+	"github.com/Azure/acs-engine/pkg/statestore"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -26,10 +23,12 @@ const (
 
 type upgradeCmd struct {
 	authArgs
+	translation
 
 	// user input
 	resourceGroupName   string
 	deploymentDirectory string
+	stateStoreURI       string
 	upgradeVersion      string
 	containerService    *api.ContainerService
 	apiVersion          string
@@ -37,7 +36,8 @@ type upgradeCmd struct {
 
 	// derived
 	client              armhelpers.ACSEngineClient
-	locale              *gotext.Locale
+	stateStore          statestore.Store
+	fs                  statestore.Filesystem
 	nameSuffix          string
 	agentPoolsToUpgrade []string
 }
@@ -59,6 +59,7 @@ func newUpgradeCmd() *cobra.Command {
 	f.StringVar(&uc.location, "location", "", "location the cluster is deployed in")
 	f.StringVar(&uc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
 	f.StringVar(&uc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
+	f.StringVar(&uc.stateStoreURI, "state-store", "", "the state store the cluster was generated into, instead of --deployment-dir: a local directory, or azblob://<account>/<container>[/<prefix>] with the account key in $AZURE_STORAGE_ACCOUNT_KEY")
 	f.StringVar(&uc.upgradeVersion, "upgrade-version", "", "desired kubernetes version")
 	addAuthFlags(&uc.authArgs, f)
 
@@ -95,9 +96,16 @@ func (uc *upgradeCmd) validate(cmd *cobra.Command, args []string) {
 		log.Error("Failed to get client:", err)
 	}
 
-	if uc.deploymentDirectory == "" {
+	if uc.deploymentDirectory == "" && uc.stateStoreURI == "" {
 		cmd.Usage()
-		log.Fatal("--deployment-dir must be specified")
+		log.Fatal("--deployment-dir or --state-store must be specified")
+	}
+	if uc.stateStoreURI != "" {
+		if uc.stateStore, err = openStateStore(uc.stateStoreURI); err != nil {
+			log.Fatalf("error opening the state store: %s", err.Error())
+		}
+	} else {
+		uc.stateStore = statestore.NewFilesystemStore(uc.fs, uc.deploymentDirectory)
 	}
 
 	_, err = uc.client.EnsureResourceGroup(uc.resourceGroupName, uc.location, nil)
@@ -105,19 +113,18 @@ func (uc *upgradeCmd) validate(cmd *cobra.Command, args []string) {
 		log.Fatalln(err)
 	}
 
-	// load apimodel from the deployment directory
-	apiModelPath := path.Join(uc.deploymentDirectory, "apimodel.json")
-
-	if _, err = os.Stat(apiModelPath); os.IsNotExist(err) {
-		log.Fatalf("specified api model does not exist (%s)", apiModelPath)
+	// load apimodel from the state store
+	apiModel, err := uc.stateStore.Load(statestore.APIModelKey)
+	if err == statestore.ErrNotFound {
+		log.Fatalf("specified api model does not exist (%s)", statestore.APIModelKey)
+	} else if err != nil {
+		log.Fatalf("error reading the api model: %s", err.Error())
 	}
 
 	apiloader := &api.Apiloader{
-		Translator: &i18n.Translator{
-			Locale: uc.locale,
-		},
+		Translator: uc.getTranslator(),
 	}
-	uc.containerService, uc.apiVersion, err = apiloader.LoadContainerServiceFromFile(apiModelPath, true, nil)
+	uc.containerService, uc.apiVersion, err = apiloader.DeserializeContainerService(apiModel, true, nil)
 	if err != nil {
 		log.Fatalf("error parsing the api model: %s", err.Error())
 	}
@@ -155,22 +162,22 @@ func (uc *upgradeCmd) validate(cmd *cobra.Command, args []string) {
 	// TODO: Also update to read  namesuffix from the parameters file as
 	// user could have specified a name suffix instead of using the default
 	// value generated by ACS Engine
-	templatePath := path.Join(uc.deploymentDirectory, "azuredeploy.json")
-	contents, _ := ioutil.ReadFile(templatePath)
-
-	var template interface{}
-	json.Unmarshal(contents, &template)
-
-	templateMap := template.(map[string]interface{})
-	templateParameters := templateMap["parameters"].(map[string]interface{})
-
-	nameSuffixParam := templateParameters["nameSuffix"].(map[string]interface{})
-	uc.nameSuffix = nameSuffixParam["defaultValue"].(string)
+	if uc.nameSuffix, err = loadNameSuffix(uc.stateStore); err != nil {
+		log.Fatalf("error reading the name suffix: %s", err.Error())
+	}
 	log.Infoln(fmt.Sprintf("Name suffix: %s", uc.nameSuffix))
 
 	uc.agentPoolsToUpgrade = []string{}
 	log.Infoln(fmt.Sprintf("Gathering agent pool names..."))
 	for _, agentPool := range uc.containerService.Properties.AgentPoolProfiles {
+		// pools pinned to a different version stay on it, as long as the new control plane still supports them
+		if agentPool.OrchestratorVersion != "" && agentPool.OrchestratorVersion != uc.upgradeVersion {
+			if err = common.ValidateKubernetesNodeVersionSkew(uc.upgradeVersion, agentPool.OrchestratorVersion); err != nil {
+				log.Fatalf("agent pool %s is pinned to version %s, which is not supported by the upgraded control plane: %s", agentPool.Name, agentPool.OrchestratorVersion, err.Error())
+			}
+			log.Infoln(fmt.Sprintf("Skipping agent pool %s pinned to version %s", agentPool.Name, agentPool.OrchestratorVersion))
+			continue
+		}
 		uc.agentPoolsToUpgrade = append(uc.agentPoolsToUpgrade, agentPool.Name)
 	}
 }
@@ -179,11 +186,9 @@ func (uc *upgradeCmd) run(cmd *cobra.Command, args []string) error {
 	uc.validate(cmd, args)
 
 	upgradeCluster := kubernetesupgrade.UpgradeCluster{
-		Translator: &i18n.Translator{
-			Locale: uc.locale,
-		},
-		Logger: log.NewEntry(log.New()),
-		Client: uc.client,
+		Translator: uc.getTranslator(),
+		Logger:     log.NewEntry(log.New()),
+		Client:     uc.client,
 	}
 	kubeConfig, err := acsengine.GenerateKubeConfig(uc.containerService.Properties, uc.location)
 	if err != nil {
@@ -195,5 +200,22 @@ func (uc *upgradeCmd) run(cmd *cobra.Command, args []string) error {
 		log.Fatalf("Error upgrading cluster: %s \n", err.Error())
 	}
 
+	// record the upgraded version in the state store, so that later operations start from the
+	// state of the cluster; the apimodel of a deployment directory is the user's input and is
+	// left as is
+	if uc.stateStoreURI == "" {
+		return nil
+	}
+	apiloader := &api.Apiloader{
+		Translator: uc.getTranslator(),
+	}
+	apiModel, err := apiloader.SerializeContainerService(uc.containerService, uc.apiVersion)
+	if err != nil {
+		log.Fatalf("error serializing the upgraded api model: %s", err.Error())
+	}
+	if err = uc.stateStore.Save(statestore.APIModelKey, apiModel); err != nil {
+		log.Fatalf("error saving the upgraded api model: %s", err.Error())
+	}
+
 	return nil
 }
@@ -1,6 +1,10 @@
 {{if .IsStorageAccount}}
     "{{.Name}}StorageAccountOffset": "[mul(variables('maxStorageAccountsPerAgent'),variables('{{.Name}}Index'))]",
+  {{if .SpreadStorageAccounts}}
+    "{{.Name}}StorageAccountsCount": "[min(variables('{{.Name}}Count'), variables('maxStorageAccountsPerAgent'))]",
+  {{else}}
     "{{.Name}}StorageAccountsCount": "[add(div(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')), mod(add(mod(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')),2), add(mod(variables('{{.Name}}Count'), variables('maxVMsPerStorageAccount')),1)))]",
+  {{end}}
 {{end}}
     "{{.Name}}Count": "[parameters('{{.Name}}Count')]",
     "{{.Name}}Offset": "[parameters('{{.Name}}Offset')]",
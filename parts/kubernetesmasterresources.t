@@ -21,11 +21,23 @@
     },
     {
       "apiVersion": "[variables('apiVersionStorage')]",
+  {{if .MasterProfile.SpreadStorageAccounts}}
+      "copy": {
+        "count": "[sub(variables('masterCount'), variables('masterOffset'))]",
+        "name": "masterStorageAccountLoop"
+      },
+  {{end}}
+  {{if not .MasterProfile.HasExistingPublicIP}}
       "dependsOn": [
         "[concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))]"
       ],
+  {{end}}
       "location": "[variables('location')]",
+  {{if .MasterProfile.SpreadStorageAccounts}}
+      "name": "[concat(variables('storageAccountBaseName'), 'mstr', copyIndex(variables('masterOffset')))]",
+  {{else}}
       "name": "[variables('masterStorageAccountName')]",
+  {{end}}
       "properties": {
         "accountType": "[variables('vmSizesMap')[variables('masterVMSize')].storageAccountType]"
       },
@@ -135,9 +147,11 @@
 {{end}}
     {
       "apiVersion": "[variables('apiVersionDefault')]",
+  {{if not .MasterProfile.HasExistingPublicIP}}
       "dependsOn": [
         "[concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))]"
       ],
+  {{end}}
       "location": "[variables('location')]",
       "name": "[variables('masterLbName')]",
       "properties": {
@@ -151,7 +165,11 @@
             "name": "[variables('masterLbIPConfigName')]",
             "properties": {
               "publicIPAddress": {
+{{if .MasterProfile.HasExistingPublicIP}}
+                "id": "[variables('masterPublicIPAddressID')]"
+{{else}}
                 "id": "[resourceId('Microsoft.Network/publicIPAddresses',variables('masterPublicIPAddressName'))]"
+{{end}}
               }
             }
           }
@@ -255,6 +273,7 @@
       "type": "Microsoft.Network/loadBalancers"
     },
 {{end}}
+{{if not .MasterProfile.HasExistingPublicIP}}
     {
       "apiVersion": "[variables('apiVersionDefault')]",
       "location": "[variables('location')]",
@@ -263,10 +282,58 @@
         "dnsSettings": {
           "domainNameLabel": "[variables('masterFqdnPrefix')]"
         },
+{{if IsMasterDNSZoneARecord}}
+        "publicIPAllocationMethod": "Static"
+{{else}}
         "publicIPAllocationMethod": "Dynamic"
+{{end}}
       },
       "type": "Microsoft.Network/publicIPAddresses"
     },
+{{end}}
+{{if .MasterProfile.HasDNSZoneRecord}}
+    {
+      "apiVersion": "[variables('apiVersionDeployments')]",
+      "dependsOn": [
+        "[variables('masterLbID')]"
+      ],
+      "name": "[concat(variables('masterFqdnPrefix'), '-apiserver-dns-', variables('nameSuffix'))]",
+      "resourceGroup": "{{.MasterProfile.DNSZoneRecord.ZoneResourceGroup}}",
+      "properties": {
+        "mode": "Incremental",
+        "template": {
+          "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+          "contentVersion": "1.0.0.0",
+          "resources": [
+            {
+              "apiVersion": "[variables('apiVersionDNSZones')]",
+              "name": "{{.MasterProfile.DNSZoneRecord.ZoneName}}/{{.MasterProfile.DNSZoneRecord.RecordName}}",
+{{if IsMasterDNSZoneARecord}}
+              "properties": {
+                "TTL": 300,
+                "ARecords": [
+                  {
+                    "ipv4Address": "[reference(variables('masterPublicIPAddressID'), variables('apiVersionDefault')).ipAddress]"
+                  }
+                ]
+              },
+              "type": "Microsoft.Network/dnszones/A"
+{{else}}
+              "properties": {
+                "TTL": 300,
+                "CNAMERecord": {
+                  "cname": "[reference(variables('masterPublicIPAddressID'), variables('apiVersionDefault')).dnsSettings.fqdn]"
+                }
+              },
+              "type": "Microsoft.Network/dnszones/CNAME"
+{{end}}
+            }
+          ]
+        }
+      },
+      "type": "Microsoft.Resources/deployments"
+    },
+{{end}}
     {
       "apiVersion": "[variables('apiVersionDefault')]",
       "copy": {
@@ -378,7 +445,9 @@
       "dependsOn": [
         "[concat('Microsoft.Network/networkInterfaces/', variables('masterVMNamePrefix'), 'nic-', copyIndex(variables('masterOffset')))]"
         ,"[concat('Microsoft.Compute/availabilitySets/',variables('masterAvailabilitySet'))]"
-{{if .MasterProfile.IsStorageAccount}}
+{{if and .MasterProfile.IsStorageAccount .MasterProfile.SpreadStorageAccounts}}
+        ,"[concat('Microsoft.Storage/storageAccounts/', {{GetMasterStorageAccountName}})]"
+{{else if .MasterProfile.IsStorageAccount}}
         ,"[variables('masterStorageAccountName')]"
 {{end}}
       ],
@@ -439,7 +508,7 @@
               ,"name": "[concat(variables('masterVMNamePrefix'), copyIndex(variables('masterOffset')),'-etcddisk')]"
           {{if .MasterProfile.IsStorageAccount}}
               ,"vhd": {
-                "uri": "[concat(reference(concat('Microsoft.Storage/storageAccounts/',variables('masterStorageAccountName')),variables('apiVersionStorage')).primaryEndpoints.blob,'vhds/', variables('masterVMNamePrefix'),copyIndex(variables('masterOffset')),'-etcddisk.vhd')]"
+                "uri": "[concat(reference(concat('Microsoft.Storage/storageAccounts/',{{GetMasterStorageAccountName}}),variables('apiVersionStorage')).primaryEndpoints.blob,'vhds/', variables('masterVMNamePrefix'),copyIndex(variables('masterOffset')),'-etcddisk.vhd')]"
               }
           {{end}}
             }
@@ -456,7 +525,7 @@
 {{if .MasterProfile.IsStorageAccount}}
             ,"name": "[concat(variables('masterVMNamePrefix'), copyIndex(variables('masterOffset')),'-osdisk')]"
             ,"vhd": {
-              "uri": "[concat(reference(concat('Microsoft.Storage/storageAccounts/',variables('masterStorageAccountName')),variables('apiVersionStorage')).primaryEndpoints.blob,'vhds/',variables('masterVMNamePrefix'),copyIndex(variables('masterOffset')),'-osdisk.vhd')]"
+              "uri": "[concat(reference(concat('Microsoft.Storage/storageAccounts/',{{GetMasterStorageAccountName}}),variables('apiVersionStorage')).primaryEndpoints.blob,'vhds/',variables('masterVMNamePrefix'),copyIndex(variables('masterOffset')),'-osdisk.vhd')]"
             }
 {{end}}
 {{if ne .MasterProfile.OSDiskSizeGB 0}}
@@ -511,7 +580,7 @@
       "apiVersion": "[variables('apiVersionDefault')]",
       "copy": {
         "count": "[sub(variables('masterCount'), variables('masterOffset'))]",
-        "name": "vmLoopNode"
+        "name": "masterCseLoopNode"
       },
       "dependsOn": [
         {{if UseManagedIdentity}}
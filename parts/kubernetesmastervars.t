@@ -174,6 +174,15 @@
     "primaryAvailabilitySetName": "[concat('{{ (index .AgentPoolProfiles 0).Name }}-availabilitySet-',variables('nameSuffix'))]",
 {{if not IsHostedMaster }}
     "masterPublicIPAddressName": "[concat(variables('orchestratorName'), '-master-ip-', variables('masterFqdnPrefix'), '-', variables('nameSuffix'))]",
+  {{if .MasterProfile.HasExistingPublicIP}}
+    "masterPublicIPAddressID": "{{.MasterProfile.PublicIPAddressID}}",
+  {{else if .MasterProfile.HasDNSZoneRecord}}
+    "masterPublicIPAddressID": "[resourceId('Microsoft.Network/publicIPAddresses',variables('masterPublicIPAddressName'))]",
+  {{end}}
+  {{if .MasterProfile.HasDNSZoneRecord}}
+    "apiVersionDeployments": "2017-05-10",
+    "apiVersionDNSZones": "2016-04-01",
+  {{end}}
     "masterLbID": "[resourceId('Microsoft.Network/loadBalancers',variables('masterLbName'))]",
     "masterLbIPConfigID": "[concat(variables('masterLbID'),'/frontendIPConfigurations/', variables('masterLbIPConfigName'))]",
     "masterLbIPConfigName": "[concat(variables('orchestratorName'), '-master-lbFrontEnd-', variables('nameSuffix'))]",
@@ -64,7 +64,7 @@
         "count": "[variables('{{.Name}}StorageAccountsCount')]",
         "name": "loop"
       },
-      {{if not IsHostedMaster}}
+      {{if not (or IsHostedMaster HasExistingMasterPublicIP)}}
       "dependsOn": [
         "[concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))]"
       ],
@@ -83,7 +83,7 @@
         "count": "[variables('{{.Name}}StorageAccountsCount')]",
         "name": "datadiskLoop"
       },
-      {{if not IsHostedMaster}}
+      {{if not (or IsHostedMaster HasExistingMasterPublicIP)}}
       "dependsOn": [
         "[concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))]"
       ],
@@ -128,7 +128,7 @@
       {
         "creationSource" : "[concat(variables('generatorCode'), '-', variables('{{.Name}}VMNamePrefix'), copyIndex(variables('{{.Name}}Offset')))]",
         "resourceNameSuffix" : "[variables('winResourceNamePrefix')]",
-        "orchestrator" : "[variables('orchestratorNameVersionTag')]",
+        "orchestrator" : "{{GetAgentOrchestratorNameVersionTag .}}",
         "poolName" : "{{.Name}}"
       },
       "location": "[variables('location')]",
@@ -234,6 +234,7 @@
         {{else}}
         "[concat('Microsoft.Compute/virtualMachines/', variables('{{.Name}}VMNamePrefix'), copyIndex(variables('{{.Name}}Offset')))]"
         {{end}}
+        {{GetMasterProvisioningDependencies}}
       ],
       "location": "[variables('location')]",
       "type": "Microsoft.Compute/virtualMachines/extensions",
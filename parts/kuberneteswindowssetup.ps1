@@ -45,8 +45,8 @@ $global:AgentCertificate = "{{WrapAsVariable "clientCertificate"}}"
 $global:DockerServiceName = "Docker"
 $global:RRASServiceName = "RemoteAccess"
 $global:KubeDir = "c:\k"
-$global:KubeBinariesSASURL = "{{WrapAsVariable "kubeBinariesSASURL"}}"
-$global:KubeBinariesVersion = "{{WrapAsVariable "kubeBinariesVersion"}}"
+$global:KubeBinariesSASURL = "{{GetAgentKubeBinariesSASURL .}}"
+$global:KubeBinariesVersion = "{{GetAgentKubeBinariesVersion .}}"
 $global:WindowsTelemetryGUID = "{{WrapAsVariable "windowsTelemetryGUID"}}"
 $global:KubeletStartFile = $global:KubeDir + "\kubeletstart.ps1"
 $global:KubeProxyStartFile = $global:KubeDir + "\kubeproxystart.ps1"
@@ -1,6 +1,12 @@
     "masterFQDN": {
       "type": "string", 
+{{if .MasterProfile.HasDNSZoneRecord}}
+      "value": "{{.MasterProfile.DNSZoneRecord.FQDN}}"
+{{else if .MasterProfile.HasExistingPublicIP}}
+      "value": "[reference(variables('masterPublicIPAddressID'), variables('apiVersionDefault')).dnsSettings.fqdn]"
+{{else}}
       "value": "[reference(concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))).dnsSettings.fqdn]"
+{{end}}
     }
 {{if  GetClassicMode}}
     ,
@@ -84,6 +84,8 @@ const (
 	DefaultGeneratorCode = "acsengine"
 	// DefaultOrchestratorName specifies the 3 character orchestrator code of the cluster template and affects resource naming.
 	DefaultOrchestratorName = "k8s"
+	// MaxCustomDataBase64Length is the largest base64 encoded customData that Azure accepts for a VM or VMSS
+	MaxCustomDataBase64Length = 87380
 )
 
 const (
@@ -3,10 +3,12 @@ package acsengine
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/api/common"
 	"github.com/Masterminds/semver"
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -135,6 +137,10 @@ var (
 
 // SetPropertiesDefaults for the container Properties, returns true if certs are generated
 func SetPropertiesDefaults(cs *api.ContainerService) (bool, error) {
+	return setPropertiesDefaults(cs, NoopMetricsRecorder{}, log.NewEntry(log.StandardLogger()))
+}
+
+func setPropertiesDefaults(cs *api.ContainerService, metrics MetricsRecorder, logger *log.Entry) (bool, error) {
 	properties := cs.Properties
 
 	setOrchestratorDefaults(cs)
@@ -148,10 +154,18 @@ func SetPropertiesDefaults(cs *api.ContainerService) (bool, error) {
 	setStorageDefaults(properties)
 	setExtensionDefaults(properties)
 
+	if e := setDefaultWindowsPassword(properties, logger); e != nil {
+		return false, e
+	}
+
+	start := time.Now()
 	certsGenerated, e := setDefaultCerts(properties)
 	if e != nil {
 		return false, e
 	}
+	if certsGenerated {
+		metrics.ObserveCertGenerationDuration(time.Since(start))
+	}
 	return certsGenerated, nil
 }
 
@@ -328,6 +342,16 @@ func setMasterNetworkDefaults(a *api.Properties) {
 	if a.MasterProfile.HTTPSourceAddressPrefix == "" {
 		a.MasterProfile.HTTPSourceAddressPrefix = "*"
 	}
+
+	// The apiserver record defaults to a CNAME named after the dns prefix
+	if r := a.MasterProfile.DNSZoneRecord; r != nil {
+		if r.RecordName == "" {
+			r.RecordName = a.MasterProfile.DNSPrefix
+		}
+		if r.RecordType == "" {
+			r.RecordType = api.DNSRecordTypeCNAME
+		}
+	}
 }
 
 // SetAgentNetworkDefaults for agents
@@ -392,6 +416,9 @@ func setDefaultCerts(a *api.Properties) (bool, error) {
 	}
 
 	masterExtraFQDNs := FormatAzureProdFQDNs(a.MasterProfile.DNSPrefix)
+	if a.MasterProfile.HasDNSZoneRecord() {
+		masterExtraFQDNs = append(masterExtraFQDNs, a.MasterProfile.DNSZoneRecord.FQDN())
+	}
 	firstMasterIP := net.ParseIP(a.MasterProfile.FirstConsecutiveStaticIP).To4()
 
 	if firstMasterIP == nil {
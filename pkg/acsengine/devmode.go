@@ -0,0 +1,46 @@
+package acsengine
+
+import (
+	"github.com/Azure/acs-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+)
+
+// DevModeVMSize is the VM size of the masters and agents of a dev mode cluster
+const DevModeVMSize = "Standard_D2_v2"
+
+// SetDevModeOverrides shrinks the cluster to the smallest viable configuration, one master
+// and one agent per pool of DevModeVMSize VMs with default OS disks, so that a throwaway
+// cluster can be deployed from a production apimodel. Every override is logged as a warning
+// to logger, or to the standard logger when it is nil.
+func SetDevModeOverrides(a *api.Properties, logger *log.Entry) {
+	logger = loggerOrStandard(logger)
+	if m := a.MasterProfile; m != nil {
+		if m.Count != 1 {
+			logger.Warnf("dev mode: masterProfile.count overridden from %d to 1", m.Count)
+			m.Count = 1
+		}
+		if m.VMSize != DevModeVMSize {
+			logger.Warnf("dev mode: masterProfile.vmSize overridden from %s to %s", m.VMSize, DevModeVMSize)
+			m.VMSize = DevModeVMSize
+		}
+		if m.OSDiskSizeGB != 0 {
+			logger.Warnf("dev mode: masterProfile.osDiskSizeGB overridden from %d to the default", m.OSDiskSizeGB)
+			m.OSDiskSizeGB = 0
+		}
+	}
+
+	for _, p := range a.AgentPoolProfiles {
+		if p.Count != 1 {
+			logger.Warnf("dev mode: agentPoolProfiles[%s].count overridden from %d to 1", p.Name, p.Count)
+			p.Count = 1
+		}
+		if p.VMSize != DevModeVMSize {
+			logger.Warnf("dev mode: agentPoolProfiles[%s].vmSize overridden from %s to %s", p.Name, p.VMSize, DevModeVMSize)
+			p.VMSize = DevModeVMSize
+		}
+		if p.OSDiskSizeGB != 0 {
+			logger.Warnf("dev mode: agentPoolProfiles[%s].osDiskSizeGB overridden from %d to the default", p.Name, p.OSDiskSizeGB)
+			p.OSDiskSizeGB = 0
+		}
+	}
+}
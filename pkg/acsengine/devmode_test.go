@@ -0,0 +1,48 @@
+package acsengine
+
+import (
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestSetDevModeOverrides(t *testing.T) {
+	properties := &api.Properties{
+		MasterProfile: &api.MasterProfile{
+			Count:        5,
+			VMSize:       "Standard_D5_v2",
+			OSDiskSizeGB: 1023,
+		},
+		AgentPoolProfiles: []*api.AgentPoolProfile{
+			{
+				Name:   "agentpool1",
+				Count:  100,
+				VMSize: "Standard_D14_v2",
+			},
+			{
+				Name:         "windowspool",
+				Count:        20,
+				VMSize:       "Standard_D3_v2",
+				OSType:       api.Windows,
+				OSDiskSizeGB: 256,
+				DiskSizesGB:  []int{128},
+			},
+		},
+	}
+
+	SetDevModeOverrides(properties, log.NewEntry(log.New()))
+
+	m := properties.MasterProfile
+	if m.Count != 1 || m.VMSize != DevModeVMSize || m.OSDiskSizeGB != 0 {
+		t.Errorf("expected a single default master, got count %d, size %s, os disk %d", m.Count, m.VMSize, m.OSDiskSizeGB)
+	}
+	for _, p := range properties.AgentPoolProfiles {
+		if p.Count != 1 || p.VMSize != DevModeVMSize || p.OSDiskSizeGB != 0 {
+			t.Errorf("expected a single default agent in pool %s, got count %d, size %s, os disk %d", p.Name, p.Count, p.VMSize, p.OSDiskSizeGB)
+		}
+	}
+	if len(properties.AgentPoolProfiles[1].DiskSizesGB) != 1 {
+		t.Errorf("expected the data disks to be kept")
+	}
+}
@@ -17,12 +17,14 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	//log "github.com/sirupsen/logrus"
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/i18n"
 	"github.com/Masterminds/semver"
 	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -194,8 +196,12 @@ type paramsMap map[string]interface{}
 
 var keyvaultSecretPathRe *regexp.Regexp
 
+// armExpressionRe matches an ARM expression spliced into a single line concat() literal, e.g. ',variables('foo'),'
+var armExpressionRe *regexp.Regexp
+
 func init() {
 	keyvaultSecretPathRe = regexp.MustCompile(`^(/subscriptions/\S+/resourceGroups/\S+/providers/Microsoft.KeyVault/vaults/\S+)/secrets/([^/\s]+)(/(\S+))?$`)
+	armExpressionRe = regexp.MustCompile(`',[A-Za-z]+\(`)
 }
 
 func (t *TemplateGenerator) verifyFiles() error {
@@ -212,9 +218,17 @@ func (t *TemplateGenerator) verifyFiles() error {
 }
 
 // TemplateGenerator represents the object that performs the template generation.
+// A TemplateGenerator is safe for concurrent use by multiple goroutines, as long as
+// each GenerateTemplate call is passed its own ContainerService.
 type TemplateGenerator struct {
 	ClassicMode bool
 	Translator  *i18n.Translator
+	Metrics     MetricsRecorder
+	// AgentsWaitForMasters makes the Kubernetes agents start provisioning only once
+	// every master (and so etcd) has finished provisioning
+	AgentsWaitForMasters bool
+	// Logger receives the log of the generation; the standard logger does when it is nil
+	Logger *logrus.Entry
 }
 
 // InitializeTemplateGenerator creates a new template generator object
@@ -222,6 +236,8 @@ func InitializeTemplateGenerator(ctx Context, classicMode bool) (*TemplateGenera
 	t := &TemplateGenerator{
 		ClassicMode: classicMode,
 		Translator:  ctx.Translator,
+		Metrics:     MetricsOrNoop(ctx.Metrics),
+		Logger:      ctx.Logger,
 	}
 
 	if err := t.verifyFiles(); err != nil {
@@ -231,21 +247,37 @@ func InitializeTemplateGenerator(ctx Context, classicMode bool) (*TemplateGenera
 	return t, nil
 }
 
-// GenerateTemplate generates the template from the API Model
+// GenerateTemplate generates the template from the API Model.
+// It sets the defaults on containerService, so the caller must not share it with other goroutines.
 func (t *TemplateGenerator) GenerateTemplate(containerService *api.ContainerService, generatorCode string) (templateRaw string, parametersRaw string, certsGenerated bool, err error) {
 	// named return values are used in order to set err in case of a panic
 	templateRaw = ""
 	parametersRaw = ""
 	err = nil
 
+	metrics := MetricsOrNoop(t.Metrics)
+	start := time.Now()
+	stage := GenerationStageDefaults
+	// registered first so that it runs after the panic handler below has set err
+	defer func() {
+		if err != nil {
+			metrics.IncGenerationErrors(stage)
+			return
+		}
+		metrics.ObserveGenerationDuration(time.Since(start))
+		metrics.ObserveTemplateSize(len(templateRaw))
+	}()
+
 	var templ *template.Template
 
 	properties := containerService.Properties
 
-	if certsGenerated, err = SetPropertiesDefaults(containerService); err != nil {
+	if certsGenerated, err = setPropertiesDefaults(containerService, metrics, loggerOrStandard(t.Logger)); err != nil {
 		return templateRaw, parametersRaw, certsGenerated, err
 	}
 
+	stage = GenerationStageTemplate
+
 	templ = template.New("acs template").Funcs(t.getTemplateFuncMap(containerService))
 
 	files, baseFile, e := t.prepareTemplateFiles(properties)
@@ -286,6 +318,7 @@ func (t *TemplateGenerator) GenerateTemplate(containerService *api.ContainerServ
 	}
 	templateRaw = b.String()
 
+	stage = GenerationStageParameters
 	var parametersMap paramsMap
 	if parametersMap, err = getParameters(containerService, t.ClassicMode, generatorCode); err != nil {
 		return templateRaw, parametersRaw, certsGenerated, err
@@ -312,8 +345,9 @@ func GenerateClusterID(properties *api.Properties) string {
 	} else {
 		h.Write([]byte(properties.AgentPoolProfiles[0].Name))
 	}
-	rand.Seed(int64(h.Sum64()))
-	return fmt.Sprintf("%08d", rand.Uint32())[:uniqueNameSuffixSize]
+	// use a private source, seeding the shared one would race with concurrent generations
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+	return fmt.Sprintf("%08d", r.Uint32())[:uniqueNameSuffixSize]
 }
 
 // GenerateKubeConfig returns a JSON string representing the KubeConfig
@@ -325,7 +359,7 @@ func GenerateKubeConfig(properties *api.Properties, location string) (string, er
 	kubeconfig := string(b)
 	// variable replacement
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"variables('caCertificate')\"}}", base64.StdEncoding.EncodeToString([]byte(properties.CertificateProfile.CaCertificate)), -1)
-	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"reference(concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))).dnsSettings.fqdn\"}}", FormatAzureProdFQDN(properties.MasterProfile.DNSPrefix, location), -1)
+	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVerbatim \"reference(concat('Microsoft.Network/publicIPAddresses/', variables('masterPublicIPAddressName'))).dnsSettings.fqdn\"}}", getMasterFQDN(properties.MasterProfile, location), -1)
 	kubeconfig = strings.Replace(kubeconfig, "{{WrapAsVariable \"resourceGroup\"}}", properties.MasterProfile.DNSPrefix, -1)
 
 	var authInfo string
@@ -382,6 +416,16 @@ func FormatAzureProdFQDNs(fqdnPrefix string) []string {
 	return fqdns
 }
 
+// getMasterFQDN returns the FQDN of the apiserver: the record in the DNS zone if any,
+// or else the FQDN of the master public IP in the location, in the cloud of the location
+func getMasterFQDN(m *api.MasterProfile, location string) string {
+	if m.HasDNSZoneRecord() {
+		return m.DNSZoneRecord.FQDN()
+	}
+	loc := strings.ToLower(strings.Join(strings.Fields(location), ""))
+	return fmt.Sprintf("%s.%s.%s", m.DNSPrefix, loc, GetCloudSpecConfig(location).EndpointConfig.ResourceManagerVMDNSSuffix)
+}
+
 // FormatAzureProdFQDN constructs an Azure prod fqdn
 func FormatAzureProdFQDN(fqdnPrefix string, location string) string {
 	FQDNFormat := AzureCloudSpec.EndpointConfig.ResourceManagerVMDNSSuffix
@@ -688,14 +732,30 @@ func addKeyvaultReference(m paramsMap, k string, vaultID, secretName, secretVers
 	}
 }
 
+// GetKeyVaultRef returns the key vault reference of an api model key vault secret path,
+// or nil if the value is not a secret path
+func GetKeyVaultRef(value string) *KeyVaultRef {
+	parts := keyvaultSecretPathRe.FindStringSubmatch(value)
+	if parts == nil || len(parts) != 5 {
+		return nil
+	}
+	return &KeyVaultRef{
+		KeyVault: KeyVaultID{
+			ID: parts[1],
+		},
+		SecretName:    parts[2],
+		SecretVersion: parts[4],
+	}
+}
+
 func addSecret(m paramsMap, k string, v interface{}, encode bool) {
 	str, ok := v.(string)
 	if !ok {
 		addValue(m, k, v)
 		return
 	}
-	parts := keyvaultSecretPathRe.FindStringSubmatch(str)
-	if parts == nil || len(parts) != 5 {
+	ref := GetKeyVaultRef(str)
+	if ref == nil {
 		if encode {
 			addValue(m, k, base64.StdEncoding.EncodeToString([]byte(str)))
 		} else {
@@ -703,7 +763,7 @@ func addSecret(m paramsMap, k string, v interface{}, encode bool) {
 		}
 		return
 	}
-	addKeyvaultReference(m, k, parts[1], parts[2], parts[4])
+	addKeyvaultReference(m, k, ref.KeyVault.ID, ref.SecretName, ref.SecretVersion)
 }
 
 // getStorageAccountType returns the support managed disk storage tier for a give VM size
@@ -746,6 +806,42 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 			constraint, _ := semver.NewConstraint("~" + version)
 			return cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes && constraint.Check(orchestratorVersion)
 		},
+		"IsAgentKubernetesVersionGe": func(profile *api.AgentPoolProfile, version string) bool {
+			orchestratorVersion, _ := semver.NewVersion(profile.GetOrchestratorVersion(cs.Properties.OrchestratorProfile))
+			constraint, _ := semver.NewConstraint(">=" + version)
+			return cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes && constraint.Check(orchestratorVersion)
+		},
+		"IsAgentKubernetesVersionTilde": func(profile *api.AgentPoolProfile, version string) bool {
+			orchestratorVersion, _ := semver.NewVersion(profile.GetOrchestratorVersion(cs.Properties.OrchestratorProfile))
+			constraint, _ := semver.NewConstraint("~" + version)
+			return cs.Properties.OrchestratorProfile.OrchestratorType == api.Kubernetes && constraint.Check(orchestratorVersion)
+		},
+		"GetAgentKubernetesHyperkubeSpec": func(profile *api.AgentPoolProfile) string {
+			// pools running the control plane version share the cluster wide hyperkube parameter
+			if !profile.HasOrchestratorVersionSkew(cs.Properties.OrchestratorProfile) {
+				return "',variables('kubernetesHyperkubeSpec'),'"
+			}
+			return cs.Properties.OrchestratorProfile.KubernetesConfig.KubernetesImageBase + KubeConfigs[profile.OrchestratorVersion]["hyperkube"]
+		},
+		"GetAgentKubeBinariesSASURL": func(profile *api.AgentPoolProfile) string {
+			if !profile.HasOrchestratorVersionSkew(cs.Properties.OrchestratorProfile) {
+				return "',variables('kubeBinariesSASURL'),'"
+			}
+			cloudSpecConfig := GetCloudSpecConfig(cs.Location)
+			return cloudSpecConfig.KubernetesSpecConfig.KubeBinariesSASURLBase + KubeConfigs[profile.OrchestratorVersion]["windowszip"]
+		},
+		"GetAgentKubeBinariesVersion": func(profile *api.AgentPoolProfile) string {
+			if !profile.HasOrchestratorVersionSkew(cs.Properties.OrchestratorProfile) {
+				return "',variables('kubeBinariesVersion'),'"
+			}
+			return profile.OrchestratorVersion
+		},
+		"GetAgentOrchestratorNameVersionTag": func(profile *api.AgentPoolProfile) string {
+			if !profile.HasOrchestratorVersionSkew(cs.Properties.OrchestratorProfile) {
+				return "[variables('orchestratorNameVersionTag')]"
+			}
+			return fmt.Sprintf("%s:%s", cs.Properties.OrchestratorProfile.OrchestratorType, profile.OrchestratorVersion)
+		},
 		"GetKubernetesLabels": func(profile *api.AgentPoolProfile) string {
 			var buf bytes.Buffer
 			buf.WriteString(fmt.Sprintf("kubernetes.io/role=agent,agentpool=%s", profile.Name))
@@ -804,7 +900,29 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 		"GetDataDisks": func(profile *api.AgentPoolProfile) string {
 			return getDataDisks(profile)
 		},
-		"GetDCOSMasterCustomData": func() string {
+		"GetAgentStorageAccountIndex": func(profile *api.AgentPoolProfile) string {
+			return getAgentStorageAccountIndex(profile)
+		},
+		"GetMasterStorageAccountName": func() string {
+			if cs.Properties.MasterProfile.IsStorageAccount() && cs.Properties.MasterProfile.SpreadStorageAccounts {
+				return "concat(variables('storageAccountBaseName'), 'mstr', copyIndex(variables('masterOffset')))"
+			}
+			return "variables('masterStorageAccountName')"
+		},
+		"HasExistingMasterPublicIP": func() bool {
+			return cs.Properties.MasterProfile != nil && cs.Properties.MasterProfile.HasExistingPublicIP()
+		},
+		"IsMasterDNSZoneARecord": func() bool {
+			return cs.Properties.MasterProfile != nil && cs.Properties.MasterProfile.HasDNSZoneRecord() &&
+				cs.Properties.MasterProfile.DNSZoneRecord.RecordType == api.DNSRecordTypeA
+		},
+		"GetMasterProvisioningDependencies": func() string {
+			if !t.AgentsWaitForMasters || cs.Properties.MasterProfile == nil {
+				return ""
+			}
+			return getMasterProvisioningDependencies()
+		},
+		"GetDCOSMasterCustomData": func() (string, error) {
 			masterProvisionScript := getDCOSMasterProvisionScript()
 			masterAttributeContents := getDCOSMasterCustomNodeLabels()
 			masterPreprovisionExtension := ""
@@ -819,9 +937,12 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 				cs.Properties.MasterProfile.Count, masterProvisionScript,
 				masterAttributeContents, masterPreprovisionExtension)
 
-			return fmt.Sprintf("\"customData\": \"[base64(concat('#cloud-config\\n\\n', '%s'))]\",", str)
+			if err := validateCustomDataSize("the master profile", str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"[base64(concat('#cloud-config\\n\\n', '%s'))]\",", str), nil
 		},
-		"GetDCOSAgentCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetDCOSAgentCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			agentProvisionScript := getDCOSAgentProvisionScript(profile)
 			attributeContents := getDCOSAgentCustomNodeLabels(profile)
 			agentPreprovisionExtension := ""
@@ -836,11 +957,17 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 				cs.Properties.MasterProfile.Count, agentProvisionScript,
 				attributeContents, agentPreprovisionExtension)
 
-			return fmt.Sprintf("\"customData\": \"[base64(concat('#cloud-config\\n\\n', '%s'))]\",", str)
+			if err := validateCustomDataSize(fmt.Sprintf("agent pool %s", profile.Name), str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"[base64(concat('#cloud-config\\n\\n', '%s'))]\",", str), nil
 		},
-		"GetDCOSWindowsAgentCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetDCOSWindowsAgentCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			str := getBase64CustomScript(dcosWindowsProvision)
-			return fmt.Sprintf("\"customData\": \"%s\"", str)
+			if err := validateBase64CustomDataSize(fmt.Sprintf("agent pool %s", profile.Name), str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"%s\"", str), nil
 		},
 		"GetDCOSWindowsAgentCustomNodeAttributes": func(profile *api.AgentPoolProfile) string {
 			return getDCOSWindowsAgentCustomAttributes(profile)
@@ -885,10 +1012,10 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 		"GetKubernetesMasterCustomScript": func() string {
 			return getBase64CustomScript(kubernetesMasterCustomScript)
 		},
-		"GetKubernetesMasterCustomData": func(profile *api.Properties) string {
+		"GetKubernetesMasterCustomData": func(profile *api.Properties) (string, error) {
 			str, e := t.getSingleLineForTemplate(kubernetesMasterCustomDataYaml, cs, profile)
 			if e != nil {
-				return ""
+				return "", e
 			}
 
 			for placeholder, filename := range kubernetesManifestYamls {
@@ -921,28 +1048,29 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 
 			// add calico manifests
 			if profile.OrchestratorProfile.KubernetesConfig.NetworkPolicy == "calico" {
+				calicoYamls := calicoAddonYamls
 				if profile.OrchestratorProfile.OrchestratorVersion == api.KubernetesVersion1Dot5Dot8 ||
 					profile.OrchestratorProfile.OrchestratorVersion == api.KubernetesVersion1Dot6Dot11 {
-					calicoAddonYamls = calicoAddonYamls15
+					calicoYamls = calicoAddonYamls15
 				}
-				for placeholder, filename := range calicoAddonYamls {
+				for placeholder, filename := range calicoYamls {
 					addonTextContents := getBase64CustomScript(filename)
 					str = strings.Replace(str, placeholder, addonTextContents, -1)
 				}
 			}
 
 			// return the custom data
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
+			return formatCustomData("the master profile", str, true)
 		},
-		"GetKubernetesAgentCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetKubernetesAgentCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			str, e := t.getSingleLineForTemplate(kubernetesAgentCustomDataYaml, cs, profile)
 			if e != nil {
-				return ""
+				return "", e
 			}
 
 			// add artifacts
 			var artifiacts map[string]string
-			if cs.Properties.OrchestratorProfile.OrchestratorVersion == api.KubernetesVersion1Dot5Dot8 {
+			if profile.GetOrchestratorVersion(cs.Properties.OrchestratorProfile) == api.KubernetesVersion1Dot5Dot8 {
 				artifiacts = kubernetesAritfacts15
 			} else {
 				artifiacts = kubernetesAritfacts
@@ -952,7 +1080,7 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 				str = strings.Replace(str, placeholder, addonTextContents, -1)
 			}
 
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
+			return formatCustomData(fmt.Sprintf("agent pool %s", profile.Name), str, true)
 		},
 		"WriteLinkedTemplatesForExtensions": func() string {
 			extensions := getLinkedTemplatesForExtensions(cs.Properties)
@@ -983,7 +1111,7 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 			}
 			return str
 		},
-		"GetMasterSwarmCustomData": func() string {
+		"GetMasterSwarmCustomData": func() (string, error) {
 			files := []string{swarmProvision}
 			str := buildYamlFileWithWriteFiles(files)
 			if cs.Properties.MasterProfile.PreprovisionExtension != nil {
@@ -991,13 +1119,16 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 				str += "'runcmd:\n" + extensionStr + "\n\n'"
 			}
 			str = escapeSingleLine(str)
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
+			return formatCustomData("the master profile", str, true)
 		},
-		"GetAgentSwarmCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetAgentSwarmCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			files := []string{swarmProvision}
 			str := buildYamlFileWithWriteFiles(files)
 			str = escapeSingleLine(str)
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s',variables('%sRunCmdFile'),variables('%sRunCmd')))]\",", str, profile.Name, profile.Name)
+			if err := validateCustomDataSize(fmt.Sprintf("agent pool %s", profile.Name), str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"[base64(concat('%s',variables('%sRunCmdFile'),variables('%sRunCmd')))]\",", str, profile.Name, profile.Name), nil
 		},
 		"GetSwarmAgentPreprovisionExtensionCommands": func(profile *api.AgentPoolProfile) string {
 			str := ""
@@ -1010,22 +1141,29 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 		"GetLocation": func() string {
 			return cs.Location
 		},
-		"GetWinAgentSwarmCustomData": func() string {
+		"GetWinAgentSwarmCustomData": func() (string, error) {
 			str := getBase64CustomScript(swarmWindowsProvision)
-			return fmt.Sprintf("\"customData\": \"%s\"", str)
+			if err := validateBase64CustomDataSize("the windows agent pools", str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"%s\"", str), nil
 		},
-		"GetWinAgentSwarmModeCustomData": func() string {
+		"GetWinAgentSwarmModeCustomData": func() (string, error) {
 			str := getBase64CustomScript(swarmModeWindowsProvision)
-			return fmt.Sprintf("\"customData\": \"%s\"", str)
+			if err := validateBase64CustomDataSize("the windows agent pools", str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"%s\"", str), nil
 		},
-		"GetKubernetesWindowsAgentCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetKubernetesWindowsAgentCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			str, e := t.getSingleLineForTemplate(kubernetesWindowsAgentCustomDataPS1, cs, profile)
 			if e != nil {
-				return ""
+				return "", e
 			}
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
+			// the windows agents execute the custom data as a powershell script, so it cannot be compressed
+			return formatCustomData(fmt.Sprintf("agent pool %s", profile.Name), str, false)
 		},
-		"GetMasterSwarmModeCustomData": func() string {
+		"GetMasterSwarmModeCustomData": func() (string, error) {
 			files := []string{swarmModeProvision}
 			str := buildYamlFileWithWriteFiles(files)
 			if cs.Properties.MasterProfile.PreprovisionExtension != nil {
@@ -1033,13 +1171,16 @@ func (t *TemplateGenerator) getTemplateFuncMap(cs *api.ContainerService) templat
 				str += "runcmd:\n" + extensionStr + "\n\n"
 			}
 			str = escapeSingleLine(str)
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str)
+			return formatCustomData("the master profile", str, true)
 		},
-		"GetAgentSwarmModeCustomData": func(profile *api.AgentPoolProfile) string {
+		"GetAgentSwarmModeCustomData": func(profile *api.AgentPoolProfile) (string, error) {
 			files := []string{swarmModeProvision}
 			str := buildYamlFileWithWriteFiles(files)
 			str = escapeSingleLine(str)
-			return fmt.Sprintf("\"customData\": \"[base64(concat('%s',variables('%sRunCmdFile'),variables('%sRunCmd')))]\",", str, profile.Name, profile.Name)
+			if err := validateCustomDataSize(fmt.Sprintf("agent pool %s", profile.Name), str); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("\"customData\": \"[base64(concat('%s',variables('%sRunCmdFile'),variables('%sRunCmd')))]\",", str, profile.Name, profile.Name), nil
 		},
 		"GetKubernetesSubnets": func() string {
 			return getKubernetesSubnets(cs.Properties)
@@ -1513,7 +1654,7 @@ func getDataDisks(a *api.AgentPoolProfile) string {
               "lun": %d,
               "name": "[concat(variables('%sVMNamePrefix'), copyIndex(),'-datadisk%d')]",
               "vhd": {
-                "uri": "[concat('http://',variables('storageAccountPrefixes')[mod(add(add(div(copyIndex(),variables('maxVMsPerStorageAccount')),variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(add(div(copyIndex(),variables('maxVMsPerStorageAccount')),variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('%sDataAccountName'),'.blob.core.windows.net/vhds/',variables('%sVMNamePrefix'),copyIndex(), '--datadisk%d.vhd')]"
+                "uri": "[concat('http://',variables('storageAccountPrefixes')[mod(add(add(%s,variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('storageAccountPrefixes')[div(add(add(%s,variables('%sStorageAccountOffset')),variables('dataStorageAccountPrefixSeed')),variables('storageAccountPrefixesCount'))],variables('%sDataAccountName'),'.blob.core.windows.net/vhds/',variables('%sVMNamePrefix'),copyIndex(), '--datadisk%d.vhd')]"
               }
             }`
 	managedDataDisks := `            {
@@ -1521,12 +1662,16 @@ func getDataDisks(a *api.AgentPoolProfile) string {
               "lun": %d,
               "createOption": "Empty"
             }`
+	storageAccountIndex := "div(copyIndex(),variables('maxVMsPerStorageAccount'))"
+	if a.SpreadStorageAccounts {
+		storageAccountIndex = getAgentStorageAccountIndex(a)
+	}
 	for i, diskSize := range a.DiskSizesGB {
 		if i > 0 {
 			buf.WriteString(",\n")
 		}
 		if a.StorageProfile == api.StorageAccount {
-			buf.WriteString(fmt.Sprintf(dataDisks, diskSize, i, a.Name, i, a.Name, a.Name, a.Name, a.Name, i))
+			buf.WriteString(fmt.Sprintf(dataDisks, diskSize, i, a.Name, i, storageAccountIndex, a.Name, storageAccountIndex, a.Name, a.Name, a.Name, i))
 		} else if a.StorageProfile == api.ManagedDisks {
 			buf.WriteString(fmt.Sprintf(managedDataDisks, diskSize, i))
 		}
@@ -1535,6 +1680,25 @@ func getDataDisks(a *api.AgentPoolProfile) string {
 	return buf.String()
 }
 
+// getAgentStorageAccountIndex returns the ARM expression for the index, within the pool, of the
+// storage account holding the disks of the VM being copied. By default VMs fill up one storage
+// account after another; spread pools place consecutive VMs in different storage accounts.
+func getAgentStorageAccountIndex(a *api.AgentPoolProfile) string {
+	if a.SpreadStorageAccounts {
+		return fmt.Sprintf("mod(copyIndex(variables('%sOffset')),variables('%sStorageAccountsCount'))", a.Name, a.Name)
+	}
+	return fmt.Sprintf("div(copyIndex(variables('%sOffset')),variables('maxVMsPerStorageAccount'))", a.Name)
+}
+
+// getMasterProvisioningDependencies returns the dependsOn entry, preceded by a comma, on the
+// provisioning extensions of the masters. The master extension runs the provisioning script
+// synchronously, so it only completes once the master and its etcd member are up. Depending on
+// the copy loop rather than on each extension covers exactly the masters the template deploys,
+// from masterOffset on.
+func getMasterProvisioningDependencies() string {
+	return ",\n        \"masterCseLoopNode\""
+}
+
 func getSecurityRules(ports []int) string {
 	var buf bytes.Buffer
 	for index, port := range ports {
@@ -1579,6 +1743,193 @@ func escapeSingleLine(escapedStr string) string {
 	return escapedStr
 }
 
+// unescapeSingleLine reverses escapeSingleLine and the ARM quoting of a concat() literal,
+// returning the text as it will be rendered on the VM
+func unescapeSingleLine(escapedStr string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(escapedStr); i++ {
+		if escapedStr[i] == '\\' && i+1 < len(escapedStr) {
+			i++
+			if escapedStr[i] == 'n' {
+				buf.WriteByte('\n')
+			} else {
+				buf.WriteByte(escapedStr[i])
+			}
+			continue
+		}
+		buf.WriteByte(escapedStr[i])
+	}
+	return strings.Replace(buf.String(), "''", "'", -1)
+}
+
+// validateCustomDataSize estimates the base64 encoded size of single line custom data and
+// returns an error if it exceeds what Azure accepts.  ARM expressions spliced into the
+// custom data are counted at their literal length, so the estimate is a lower bound.
+func validateCustomDataSize(owner string, str string) error {
+	size := base64.StdEncoding.EncodedLen(len(unescapeSingleLine(str)))
+	if size > MaxCustomDataBase64Length {
+		return fmt.Errorf("customData for %s is at least %d bytes once base64 encoded, which exceeds the Azure limit of %d bytes", owner, size, MaxCustomDataBase64Length)
+	}
+	return nil
+}
+
+// validateBase64CustomDataSize returns an error if base64 encoded custom data exceeds what Azure accepts
+func validateBase64CustomDataSize(owner string, b64 string) error {
+	if len(b64) > MaxCustomDataBase64Length {
+		return fmt.Errorf("customData for %s is %d bytes once base64 encoded, which exceeds the Azure limit of %d bytes", owner, len(b64), MaxCustomDataBase64Length)
+	}
+	return nil
+}
+
+// formatCustomData returns the customData property for single line custom data.  When
+// cloud-config custom data is too large for Azure, it is compressed at generation time:
+// as a whole when it does not reference any ARM expressions, since cloud-init transparently
+// decompresses gzipped user data, and otherwise file by file for the write_files entries
+// that do not reference ARM expressions.
+func formatCustomData(owner string, str string, compressible bool) (string, error) {
+	err := validateCustomDataSize(owner, str)
+	if err == nil {
+		return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str), nil
+	}
+	if !compressible {
+		return "", fmt.Errorf("%s; it is run as a PowerShell script, which cannot be compressed (see docs/acsengine.md)", err.Error())
+	}
+	if !armExpressionRe.MatchString(str) {
+		compressed := getBase64CustomScriptFromStr(unescapeSingleLine(str))
+		if err = validateBase64CustomDataSize(owner, compressed); err != nil {
+			return "", fmt.Errorf("%s, even once gzipped", err.Error())
+		}
+		return fmt.Sprintf("\"customData\": \"%s\",", compressed), nil
+	}
+	str = compressStaticWriteFiles(str)
+	if err = validateCustomDataSize(owner, str); err != nil {
+		return "", fmt.Errorf("%s, even with its static files gzipped", err.Error())
+	}
+	return fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", str), nil
+}
+
+// compressStaticWriteFiles gzips the content of the write_files entries of single line
+// cloud-config custom data that neither reference ARM expressions nor are already encoded,
+// the way the Kubernetes manifests are written. Each entry is parsed as YAML, as cloud-init
+// will see it, and only re-emitted when it parses to a single file, so that entries that
+// cannot be parsed are left as is rather than corrupted.
+func compressStaticWriteFiles(str string) string {
+	lines := splitSingleLine(str)
+	start := 0
+	for start < len(lines) && strings.TrimRight(lines[start], " ") != "write_files:" {
+		start++
+	}
+	if start == len(lines) {
+		return str
+	}
+	// the entries run until the next top level key, and each starts with a sequence item at
+	// the indentation of the first one
+	end := start + 1
+	for end < len(lines) && !isTopLevelYAMLKey(lines[end]) {
+		end++
+	}
+	itemIndent := ""
+	for _, line := range lines[start+1 : end] {
+		if trimmed := strings.TrimLeft(line, " "); strings.HasPrefix(trimmed, "-") {
+			itemIndent = line[:len(line)-len(trimmed)]
+			break
+		}
+	}
+	isItem := func(line string) bool {
+		return strings.HasPrefix(line, itemIndent+"-") && !strings.HasPrefix(line, itemIndent+" ")
+	}
+
+	out := append([]string{}, lines[:start+1]...)
+	for i := start + 1; i < end; {
+		if !isItem(lines[i]) {
+			out = append(out, lines[i])
+			i++
+			continue
+		}
+		next := i + 1
+		for next < end && !isItem(lines[next]) {
+			next++
+		}
+		// keep the blank lines separating the entry from the next one
+		last := next
+		for last > i+1 && strings.TrimSpace(lines[last-1]) == "" {
+			last--
+		}
+		out = append(out, compressWriteFile(lines[i:last], itemIndent)...)
+		out = append(out, lines[last:next]...)
+		i = next
+	}
+	out = append(out, lines[end:]...)
+	return strings.Join(out, "\\n")
+}
+
+// isTopLevelYAMLKey returns true if the line of a YAML document starts a top level key
+func isTopLevelYAMLKey(line string) bool {
+	return line != "" && line[0] != ' ' && line[0] != '-' && line[0] != '#'
+}
+
+// compressWriteFile returns the single line custom data lines of a static write_files entry
+// with its content gzipped, or the lines as is if the entry is not static, cannot be parsed
+// or would not get smaller
+func compressWriteFile(lines []string, itemIndent string) []string {
+	escaped := strings.Join(lines, "\\n")
+	if armExpressionRe.MatchString(escaped) {
+		return lines
+	}
+	rendered := strings.Split(unescapeSingleLine(escaped), "\n")
+	for i, line := range rendered {
+		rendered[i] = strings.TrimPrefix(line, itemIndent)
+	}
+	entries := []map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(strings.Join(rendered, "\n")), &entries); err != nil || len(entries) != 1 {
+		return lines
+	}
+	entry := entries[0]
+	content, ok := entry["content"].(string)
+	if _, encoded := entry["encoding"]; encoded || !ok || content == "" {
+		return lines
+	}
+	// other values, e.g. unquoted octal permissions, may not survive the round trip
+	for _, v := range entry {
+		if _, ok := v.(string); !ok {
+			return lines
+		}
+	}
+	entry["encoding"] = "gz+b64"
+	entry["content"] = getBase64CustomScriptFromStr(content)
+	b, err := yaml.Marshal(entries)
+	if err != nil {
+		return lines
+	}
+
+	// the entry is spliced back into a concat() literal, so its quotes are doubled
+	compressed := strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+	for i, line := range compressed {
+		compressed[i] = escapeSingleLine(itemIndent + strings.Replace(line, "'", "''", -1))
+	}
+	if len(strings.Join(compressed, "\\n")) >= len(escaped) {
+		return lines
+	}
+	return compressed
+}
+
+// splitSingleLine splits single line custom data at its escaped newlines
+func splitSingleLine(escapedStr string) []string {
+	lines := []string{}
+	start := 0
+	for i := 0; i < len(escapedStr); i++ {
+		if escapedStr[i] != '\\' || i+1 == len(escapedStr) {
+			continue
+		}
+		if escapedStr[i+1] == 'n' {
+			lines = append(lines, escapedStr[start:i])
+			start = i + 2
+		}
+		i++
+	}
+	return append(lines, escapedStr[start:])
+}
+
 // getBase64CustomScript will return a base64 of the CSE
 func getBase64CustomScript(csFilename string) string {
 	b, err := Asset(csFilename)
@@ -8,12 +8,14 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/ghodss/yaml"
 	"github.com/leonelquinteros/gotext"
 )
 
@@ -247,6 +249,30 @@ func TestGetStorageAccountType(t *testing.T) {
 	}
 }
 
+func TestGetAgentStorageAccountIndex(t *testing.T) {
+	profile := &api.AgentPoolProfile{
+		Name:           "agentpool1",
+		StorageProfile: api.StorageAccount,
+		DiskSizesGB:    []int{128},
+	}
+	expected := "div(copyIndex(variables('agentpool1Offset')),variables('maxVMsPerStorageAccount'))"
+	if index := getAgentStorageAccountIndex(profile); index != expected {
+		t.Errorf("expected storage account index %s, got %s", expected, index)
+	}
+	if dataDisks := getDataDisks(profile); !strings.Contains(dataDisks, "div(copyIndex(),variables('maxVMsPerStorageAccount'))") {
+		t.Errorf("expected the data disks to fill up storage accounts, got %s", dataDisks)
+	}
+
+	profile.SpreadStorageAccounts = true
+	expected = "mod(copyIndex(variables('agentpool1Offset')),variables('agentpool1StorageAccountsCount'))"
+	if index := getAgentStorageAccountIndex(profile); index != expected {
+		t.Errorf("expected storage account index %s, got %s", expected, index)
+	}
+	if dataDisks := getDataDisks(profile); !strings.Contains(dataDisks, expected) {
+		t.Errorf("expected the data disks to be spread over storage accounts, got %s", dataDisks)
+	}
+}
+
 type TestARMTemplate struct {
 	Outputs map[string]OutputElement `json:"outputs"`
 	//Parameters *json.RawMessage `json:"parameters"`
@@ -317,3 +343,344 @@ func TestTemplateOutputPresence(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatCustomData(t *testing.T) {
+	small := escapeSingleLine("#cloud-config\nruncmd:\n- echo \"hello\"\n")
+	customData, err := formatCustomData("test", small, true)
+	if err != nil {
+		t.Fatalf("unexpected error formatting small custom data: %s", err)
+	}
+	if customData != fmt.Sprintf("\"customData\": \"[base64(concat('%s'))]\",", small) {
+		t.Fatalf("expected small custom data to be left uncompressed, got %s", customData)
+	}
+
+	// a highly compressible payload that is too large to pass through uncompressed
+	large := escapeSingleLine("#cloud-config\n" + strings.Repeat("# padding\n", MaxCustomDataBase64Length/10))
+	customData, err = formatCustomData("test", large, true)
+	if err != nil {
+		t.Fatalf("unexpected error formatting large custom data: %s", err)
+	}
+	if strings.Contains(customData, "concat(") {
+		t.Fatalf("expected large custom data to be compressed, got %s", customData[:64])
+	}
+
+	if _, err = formatCustomData("test", large, false); err == nil {
+		t.Fatalf("expected an error formatting large custom data that may not be compressed")
+	}
+
+	withExpression := large + "',variables('foo'),'"
+	if _, err = formatCustomData("test", withExpression, true); err == nil {
+		t.Fatalf("expected an error formatting large custom data that references ARM expressions outside of write_files")
+	}
+
+	// the static file is compressed while the file referencing an ARM expression is left as is
+	mixed := escapeSingleLine("#cloud-config\n\nwrite_files:\n" +
+		"- path: \"/opt/static.sh\"\n  permissions: \"0744\"\n  content: |\n" + strings.Repeat("    echo \"padding\"\n", MaxCustomDataBase64Length/10) + "\n" +
+		"- path: \"/opt/dynamic.conf\"\n  permissions: \"0644\"\n  content: |\n    value=',variables('foo'),'\n\n")
+	customData, err = formatCustomData("test", mixed, true)
+	if err != nil {
+		t.Fatalf("unexpected error formatting large custom data with static files: %s", err)
+	}
+	if !strings.Contains(customData, "concat(") || !strings.Contains(customData, "value=',variables('foo'),'") {
+		t.Fatalf("expected the file referencing an ARM expression to be left as is, got %s", customData)
+	}
+	if !strings.Contains(customData, "- content: H4sI") || !strings.Contains(customData, "  encoding: gz+b64\\n  path: /opt/static.sh\\n") {
+		t.Fatalf("expected the static file to be gzipped, got %s", customData[:256])
+	}
+}
+
+func TestCompressStaticWriteFiles(t *testing.T) {
+	content := strings.Repeat("echo \"it''s a \\\\ test\"\n\n", 100)
+	expected := getBase64CustomScriptFromStr(strings.TrimRight(unescapeSingleLine(escapeSingleLine(content)), "\n") + "\n")
+
+	for _, yml := range []string{
+		"write_files:\n- path: \"/opt/static.sh\"\n  permissions: \"0744\"\n  content: |\n" + indent(content, "    ") + "\nruncmd:\n- /opt/static.sh\n",
+		// the entries may be indented and their keys in any order
+		"write_files:\n  - content: |\n" + indent(content, "        ") + "    path: \"/opt/static.sh\"\n    permissions: \"0744\"\n\nruncmd:\n- /opt/static.sh\n",
+	} {
+		compressed := compressStaticWriteFiles(escapeSingleLine(yml))
+
+		cloudConfig := struct {
+			WriteFiles []map[string]string `json:"write_files"`
+			RunCmd     []string            `json:"runcmd"`
+		}{}
+		if err := yaml.Unmarshal([]byte(unescapeSingleLine(compressed)), &cloudConfig); err != nil {
+			t.Fatalf("unexpected error parsing the compressed custom data %q: %s", compressed, err)
+		}
+		if len(cloudConfig.WriteFiles) != 1 || len(cloudConfig.RunCmd) != 1 || cloudConfig.RunCmd[0] != "/opt/static.sh" {
+			t.Fatalf("unexpected compressed custom data %q", compressed)
+		}
+		f := cloudConfig.WriteFiles[0]
+		if f["path"] != "/opt/static.sh" || f["permissions"] != "0744" || f["encoding"] != "gz+b64" {
+			t.Fatalf("unexpected compressed file %v", f)
+		}
+		if f["content"] != expected {
+			t.Fatalf("expected the content to be gzipped, got %q", f["content"])
+		}
+	}
+
+	// already encoded files and files whose values may not survive the round trip are left as is
+	for _, yml := range []string{
+		"write_files:\n- path: \"/opt/ca.crt\"\n  encoding: \"base64\"\n  content: |\n" + indent(content, "    "),
+		"write_files:\n- path: \"/opt/static.sh\"\n  permissions: 0744\n  content: |\n" + indent(content, "    "),
+	} {
+		if str := escapeSingleLine(yml); compressStaticWriteFiles(str) != str {
+			t.Fatalf("expected %q to be left as is", yml[:64])
+		}
+	}
+}
+
+func indent(str string, prefix string) string {
+	lines := strings.Split(strings.TrimRight(str, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestUnescapeSingleLine(t *testing.T) {
+	raw := "line one\nquote \" and backslash \\ and it's\r\n"
+	escaped := strings.Replace(escapeSingleLine(raw), "'", "''", -1)
+	expected := strings.Replace(raw, "\r\n", "\n", -1)
+	if unescaped := unescapeSingleLine(escaped); unescaped != expected {
+		t.Fatalf("expected %q, got %q", expected, unescaped)
+	}
+}
+
+func TestGenerateTemplateConcurrently(t *testing.T) {
+	locale := gotext.NewLocale(path.Join("..", "..", "translations"), "en_US")
+	i18n.Initialize(locale)
+
+	apiloader := &api.Apiloader{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	ctx := Context{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	templateGenerator, err := InitializeTemplateGenerator(ctx, false)
+	if err != nil {
+		t.Fatalf("Failed to initialize template generator: %v", err)
+	}
+
+	// the variants exercise the version and network policy dependent parts of the custom data
+	variants := []struct {
+		version       string
+		networkPolicy string
+	}{
+		{api.KubernetesVersion1Dot7Dot7, ""},
+		{api.KubernetesVersion1Dot6Dot11, "calico"},
+		{api.KubernetesVersion1Dot8Dot1, "calico"},
+	}
+	load := func(i int) *api.ContainerService {
+		containerService, _, err := apiloader.LoadContainerServiceFromFile("./testdata/simple/kubernetes.json", true, nil)
+		if err != nil {
+			t.Fatalf("Failed to load container service from file: %v", err)
+		}
+		v := variants[i%len(variants)]
+		containerService.Properties.OrchestratorProfile.OrchestratorVersion = v.version
+		if containerService.Properties.OrchestratorProfile.KubernetesConfig == nil {
+			containerService.Properties.OrchestratorProfile.KubernetesConfig = &api.KubernetesConfig{}
+		}
+		containerService.Properties.OrchestratorProfile.KubernetesConfig.NetworkPolicy = v.networkPolicy
+		containerService.Properties.MasterProfile.DNSPrefix = fmt.Sprintf("concurrent%d", i%len(variants))
+		containerService.Properties.CertificateProfile = &api.CertificateProfile{}
+		addTestCertificateProfile(containerService.Properties.CertificateProfile)
+		return containerService
+	}
+
+	expected := make([]string, len(variants))
+	for i := range variants {
+		if expected[i], _, _, err = templateGenerator.GenerateTemplate(load(i), DefaultGeneratorCode); err != nil {
+			t.Fatalf("Failed to generate arm template: %v", err)
+		}
+	}
+
+	const generations = 12
+	containerServices := make([]*api.ContainerService, generations)
+	for i := range containerServices {
+		containerServices[i] = load(i)
+	}
+	templates := make([]string, generations)
+	errs := make([]error, generations)
+	var wg sync.WaitGroup
+	for i := 0; i < generations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			templates[i], _, _, errs[i] = templateGenerator.GenerateTemplate(containerServices[i], DefaultGeneratorCode)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < generations; i++ {
+		if errs[i] != nil {
+			t.Errorf("generation %d failed: %v", i, errs[i])
+			continue
+		}
+		if templates[i] != expected[i%len(variants)] {
+			t.Errorf("generation %d differs from the same apimodel generated serially", i)
+		}
+	}
+}
+
+func TestAgentsWaitForMastersWithMasterOffset(t *testing.T) {
+	locale := gotext.NewLocale(path.Join("..", "..", "translations"), "en_US")
+	i18n.Initialize(locale)
+
+	apiloader := &api.Apiloader{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	ctx := Context{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	templateGenerator, err := InitializeTemplateGenerator(ctx, false)
+	if err != nil {
+		t.Fatalf("Failed to initialize template generator: %v", err)
+	}
+	templateGenerator.AgentsWaitForMasters = true
+
+	containerService, _, err := apiloader.LoadContainerServiceFromFile("./testdata/simple/kubernetes.json", true, nil)
+	if err != nil {
+		t.Fatalf("Failed to load container service from file: %v", err)
+	}
+	containerService.Properties.MasterProfile.Count = 3
+	armTemplate, _, _, err := templateGenerator.GenerateTemplate(containerService, DefaultGeneratorCode)
+	if err != nil {
+		t.Fatalf("Failed to generate arm template: %v", err)
+	}
+
+	template := struct {
+		Resources []struct {
+			Type string `json:"type"`
+			Name string `json:"name"`
+			Copy struct {
+				Name  string `json:"name"`
+				Count string `json:"count"`
+			} `json:"copy"`
+			DependsOn []string `json:"dependsOn"`
+		} `json:"resources"`
+	}{}
+	if err = json.Unmarshal([]byte(armTemplate), &template); err != nil {
+		t.Fatalf("couldn't unmarshall ARM template: %#v\n", err)
+	}
+
+	masterExtensionLoops := map[string]bool{}
+	for _, r := range template.Resources {
+		if r.Type == "Microsoft.Compute/virtualMachines/extensions" && strings.HasPrefix(r.Name, "[concat(variables('masterVMNamePrefix')") {
+			// an upgrade deploys the masters from a nonzero masterOffset on, so the extensions
+			// the agents wait for must be indexed from the offset rather than from 0
+			if r.Copy.Count != "[sub(variables('masterCount'), variables('masterOffset'))]" || !strings.Contains(r.Name, "copyIndex(variables('masterOffset'))") {
+				t.Errorf("unexpected master extension %s with count %s", r.Name, r.Copy.Count)
+			}
+			masterExtensionLoops[r.Copy.Name] = true
+		}
+	}
+	found := false
+	for _, r := range template.Resources {
+		if r.Type != "Microsoft.Compute/virtualMachines/extensions" || !strings.Contains(r.Name, "/cse") || strings.HasPrefix(r.Name, "[concat(variables('masterVMNamePrefix')") {
+			continue
+		}
+		found = true
+		waits := false
+		for _, dependency := range r.DependsOn {
+			if strings.Contains(dependency, "variables('masterVMNamePrefix')") {
+				t.Errorf("agent extension %s depends on a fixed master %s, which is not deployed from a nonzero masterOffset", r.Name, dependency)
+			}
+			waits = waits || masterExtensionLoops[dependency]
+		}
+		if !waits {
+			t.Errorf("expected agent extension %s to depend on the master extension loop, got %v", r.Name, r.DependsOn)
+		}
+	}
+	if !found {
+		t.Errorf("expected an agent provisioning extension")
+	}
+}
+
+func TestGetMasterFQDN(t *testing.T) {
+	m := &api.MasterProfile{
+		DNSPrefix: "mycluster",
+	}
+	if fqdn := getMasterFQDN(m, "westus2"); fqdn != "mycluster.westus2.cloudapp.azure.com" {
+		t.Errorf("expected the master public IP FQDN, got %s", fqdn)
+	}
+
+	m.DNSZoneRecord = &api.DNSZoneRecord{
+		ZoneID:     "/subscriptions/SUB_ID/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/contoso.com",
+		RecordName: "k8s",
+	}
+	if fqdn := getMasterFQDN(m, "westus2"); fqdn != "k8s.contoso.com" {
+		t.Errorf("expected the DNS zone record FQDN, got %s", fqdn)
+	}
+}
+
+func TestMasterSpreadStorageAccounts(t *testing.T) {
+	locale := gotext.NewLocale(path.Join("..", "..", "translations"), "en_US")
+	i18n.Initialize(locale)
+
+	apiloader := &api.Apiloader{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	ctx := Context{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	templateGenerator, err := InitializeTemplateGenerator(ctx, false)
+	if err != nil {
+		t.Fatalf("Failed to initialize template generator: %v", err)
+	}
+
+	containerService, _, err := apiloader.LoadContainerServiceFromFile("./testdata/simple/kubernetes.json", true, nil)
+	if err != nil {
+		t.Fatalf("Failed to load container service from file: %v", err)
+	}
+	containerService.Properties.MasterProfile.StorageProfile = api.StorageAccount
+	containerService.Properties.MasterProfile.SpreadStorageAccounts = true
+	armTemplate, _, _, err := templateGenerator.GenerateTemplate(containerService, DefaultGeneratorCode)
+	if err != nil {
+		t.Fatalf("Failed to generate arm template: %v", err)
+	}
+
+	template := struct {
+		Resources []struct {
+			Type string `json:"type"`
+			Name string `json:"name"`
+			Copy struct {
+				Count string `json:"count"`
+			} `json:"copy"`
+		} `json:"resources"`
+	}{}
+	if err = json.Unmarshal([]byte(armTemplate), &template); err != nil {
+		t.Fatalf("couldn't unmarshall ARM template: %#v\n", err)
+	}
+	found := false
+	for _, r := range template.Resources {
+		if r.Type != "Microsoft.Storage/storageAccounts" || !strings.Contains(r.Name, "'mstr'") {
+			continue
+		}
+		found = true
+		// the storage accounts must be named after the same index as the master VMs using them
+		if r.Name != "[concat(variables('storageAccountBaseName'), 'mstr', copyIndex(variables('masterOffset')))]" {
+			t.Errorf("unexpected master storage account name %s", r.Name)
+		}
+		if r.Copy.Count != "[sub(variables('masterCount'), variables('masterOffset'))]" {
+			t.Errorf("unexpected master storage account count %s", r.Copy.Count)
+		}
+	}
+	if !found {
+		t.Errorf("expected a storage account per master")
+	}
+}
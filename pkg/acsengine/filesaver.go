@@ -1,17 +1,18 @@
 package acsengine
 
 import (
-	"io/ioutil"
-	"os"
 	"path"
 
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 	log "github.com/sirupsen/logrus"
 )
 
 // FileSaver represents the object that save string or byte data to file
 type FileSaver struct {
 	Translator *i18n.Translator
+	// Fs is the filesystem the files are written to; when nil it is the local filesystem
+	Fs statestore.Filesystem
 }
 
 // SaveFileString saves string to file
@@ -21,14 +22,16 @@ func (f *FileSaver) SaveFileString(dir string, file string, data string) error {
 
 // SaveFile saves binary data to file
 func (f *FileSaver) SaveFile(dir string, file string, data []byte) error {
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		if e := os.MkdirAll(dir, 0700); e != nil {
-			return f.Translator.Errorf("error creating directory '%s': %s", dir, e.Error())
-		}
+	fs := f.Fs
+	if fs == nil {
+		fs = statestore.OsFs{}
+	}
+	if e := fs.MkdirAll(dir, 0700); e != nil {
+		return f.Translator.Errorf("error creating directory '%s': %s", dir, e.Error())
 	}
 
 	path := path.Join(dir, file)
-	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+	if err := fs.WriteFile(path, []byte(data), 0600); err != nil {
 		return err
 	}
 
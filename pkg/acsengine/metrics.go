@@ -0,0 +1,61 @@
+package acsengine
+
+import (
+	"time"
+)
+
+// GenerationStage identifies a stage of the generation pipeline in error metrics
+type GenerationStage string
+
+// the stages of the generation pipeline
+const (
+	// GenerationStageLoad is loading and validating the api model
+	GenerationStageLoad GenerationStage = "load"
+	// GenerationStageDefaults is setting the api model defaults, including certificate generation
+	GenerationStageDefaults GenerationStage = "defaults"
+	// GenerationStageTemplate is rendering the ARM template
+	GenerationStageTemplate GenerationStage = "template"
+	// GenerationStageParameters is building the ARM template parameters
+	GenerationStageParameters GenerationStage = "parameters"
+	// GenerationStagePrettyPrint is pretty printing the template and parameters
+	GenerationStagePrettyPrint GenerationStage = "prettyprint"
+	// GenerationStageWrite is writing the artifacts to the output directory
+	GenerationStageWrite GenerationStage = "write"
+)
+
+// MetricsRecorder receives measurements from the generation pipeline, so that a service
+// embedding the generator can export them, e.g. as Prometheus histograms and counters.
+// Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveGenerationDuration records the time taken by a template generation
+	ObserveGenerationDuration(d time.Duration)
+	// ObserveCertGenerationDuration records the time taken to generate the cluster certificates
+	ObserveCertGenerationDuration(d time.Duration)
+	// ObserveTemplateSize records the size in bytes of a generated template
+	ObserveTemplateSize(bytes int)
+	// IncGenerationErrors counts a generation failing at the given stage
+	IncGenerationErrors(stage GenerationStage)
+}
+
+// NoopMetricsRecorder is a MetricsRecorder that discards all measurements
+type NoopMetricsRecorder struct{}
+
+// MetricsOrNoop returns metrics, or a NoopMetricsRecorder when it is nil
+func MetricsOrNoop(metrics MetricsRecorder) MetricsRecorder {
+	if metrics == nil {
+		return NoopMetricsRecorder{}
+	}
+	return metrics
+}
+
+// ObserveGenerationDuration implements MetricsRecorder
+func (NoopMetricsRecorder) ObserveGenerationDuration(d time.Duration) {}
+
+// ObserveCertGenerationDuration implements MetricsRecorder
+func (NoopMetricsRecorder) ObserveCertGenerationDuration(d time.Duration) {}
+
+// ObserveTemplateSize implements MetricsRecorder
+func (NoopMetricsRecorder) ObserveTemplateSize(bytes int) {}
+
+// IncGenerationErrors implements MetricsRecorder
+func (NoopMetricsRecorder) IncGenerationErrors(stage GenerationStage) {}
@@ -0,0 +1,120 @@
+package acsengine
+
+import (
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/leonelquinteros/gotext"
+)
+
+type testMetricsRecorder struct {
+	sync.Mutex
+	generationDurations     []time.Duration
+	certGenerationDurations []time.Duration
+	templateSizes           []int
+	errors                  map[GenerationStage]int
+}
+
+func (r *testMetricsRecorder) ObserveGenerationDuration(d time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.generationDurations = append(r.generationDurations, d)
+}
+
+func (r *testMetricsRecorder) ObserveCertGenerationDuration(d time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.certGenerationDurations = append(r.certGenerationDurations, d)
+}
+
+func (r *testMetricsRecorder) ObserveTemplateSize(bytes int) {
+	r.Lock()
+	defer r.Unlock()
+	r.templateSizes = append(r.templateSizes, bytes)
+}
+
+func (r *testMetricsRecorder) IncGenerationErrors(stage GenerationStage) {
+	r.Lock()
+	defer r.Unlock()
+	if r.errors == nil {
+		r.errors = map[GenerationStage]int{}
+	}
+	r.errors[stage]++
+}
+
+func loadMetricsTestContainerService(t *testing.T, locale *gotext.Locale) *api.ContainerService {
+	apiloader := &api.Apiloader{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+	}
+	containerService, _, err := apiloader.LoadContainerServiceFromFile("./testdata/simple/kubernetes.json", true, nil)
+	if err != nil {
+		t.Fatalf("Failed to load container service from file: %v", err)
+	}
+	return containerService
+}
+
+func TestGenerateTemplateRecordsMetrics(t *testing.T) {
+	locale := gotext.NewLocale(path.Join("..", "..", "translations"), "en_US")
+	i18n.Initialize(locale)
+
+	recorder := &testMetricsRecorder{}
+	ctx := Context{
+		Translator: &i18n.Translator{
+			Locale: locale,
+		},
+		Metrics: recorder,
+	}
+	templateGenerator, err := InitializeTemplateGenerator(ctx, false)
+	if err != nil {
+		t.Fatalf("Failed to initialize template generator: %v", err)
+	}
+
+	containerService := loadMetricsTestContainerService(t, locale)
+	armTemplate, _, certsGenerated, err := templateGenerator.GenerateTemplate(containerService, DefaultGeneratorCode)
+	if err != nil {
+		t.Fatalf("Failed to generate arm template: %v", err)
+	}
+
+	if len(recorder.generationDurations) != 1 {
+		t.Errorf("expected 1 generation duration, got %d", len(recorder.generationDurations))
+	}
+	if len(recorder.templateSizes) != 1 || recorder.templateSizes[0] != len(armTemplate) {
+		t.Errorf("expected a template size of %d, got %v", len(armTemplate), recorder.templateSizes)
+	}
+	if certsGenerated && len(recorder.certGenerationDurations) != 1 {
+		t.Errorf("expected 1 cert generation duration, got %d", len(recorder.certGenerationDurations))
+	}
+	if len(recorder.errors) != 0 {
+		t.Errorf("expected no errors, got %v", recorder.errors)
+	}
+
+	// an invalid master IP fails certificate generation while setting the defaults
+	containerService = loadMetricsTestContainerService(t, locale)
+	containerService.Properties.MasterProfile.VnetSubnetID = "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/virtualNetworks/VNET_NAME/subnets/SUBNET_NAME"
+	containerService.Properties.MasterProfile.FirstConsecutiveStaticIP = "not-an-ip"
+	if _, _, _, err = templateGenerator.GenerateTemplate(containerService, DefaultGeneratorCode); err == nil {
+		t.Fatalf("expected an error generating the template")
+	}
+	if recorder.errors[GenerationStageDefaults] != 1 {
+		t.Errorf("expected 1 error in stage %s, got %v", GenerationStageDefaults, recorder.errors)
+	}
+	if len(recorder.generationDurations) != 1 {
+		t.Errorf("expected a failed generation not to record a duration, got %d", len(recorder.generationDurations))
+	}
+}
+
+func TestMetricsOrNoop(t *testing.T) {
+	if _, ok := MetricsOrNoop(nil).(NoopMetricsRecorder); !ok {
+		t.Errorf("expected a NoopMetricsRecorder when no recorder is given")
+	}
+	r := &testMetricsRecorder{}
+	if MetricsOrNoop(r) != r {
+		t.Errorf("expected the given recorder to be returned")
+	}
+}
@@ -6,23 +6,52 @@ import (
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 )
 
 // ArtifactWriter represents the object that writes artifacts
 type ArtifactWriter struct {
 	Translator *i18n.Translator
+	// Store receives the artifacts; when nil they are written to the artifacts directory
+	Store statestore.Store
+	// Fs is the filesystem of the artifacts directory; when nil it is the local filesystem
+	Fs statestore.Filesystem
+
+	// artifacts are the keys of the artifacts written so far
+	artifacts []string
 }
 
-// WriteTLSArtifacts saves TLS certificates and keys to the server filesystem
-func (w *ArtifactWriter) WriteTLSArtifacts(containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool) error {
-	if len(artifactsDir) == 0 {
-		artifactsDir = fmt.Sprintf("%s-%s", containerService.Properties.OrchestratorProfile.OrchestratorType, GenerateClusterID(containerService.Properties))
-		artifactsDir = path.Join("_output", artifactsDir)
+// Artifacts returns the keys of the artifacts written so far, in the order they were written
+func (w *ArtifactWriter) Artifacts() []string {
+	return append([]string{}, w.artifacts...)
+}
+
+// store returns the store the artifacts of containerService are written to
+func (w *ArtifactWriter) store(containerService *api.ContainerService, artifactsDir string) statestore.Store {
+	f := w.Store
+	if f == nil {
+		if len(artifactsDir) == 0 {
+			artifactsDir = fmt.Sprintf("%s-%s", containerService.Properties.OrchestratorProfile.OrchestratorType, GenerateClusterID(containerService.Properties))
+			artifactsDir = path.Join("_output", artifactsDir)
+		}
+		f = statestore.NewFilesystemStore(w.Fs, artifactsDir)
 	}
+	return &recordingStore{Store: f, writer: w}
+}
 
-	f := &FileSaver{
-		Translator: w.Translator,
+// WriteSummary saves the generation summary to the state store, and adds it to its artifacts
+func (w *ArtifactWriter) WriteSummary(containerService *api.ContainerService, summary *GenerationSummary, artifactsDir string) error {
+	summary.Artifacts = append(w.Artifacts(), statestore.SummaryKey)
+	b, err := summary.JSON()
+	if err != nil {
+		return err
 	}
+	return w.store(containerService, artifactsDir).Save(statestore.SummaryKey, b)
+}
+
+// WriteTLSArtifacts saves the apimodel, the templates and the TLS certificates and keys to the state store
+func (w *ArtifactWriter) WriteTLSArtifacts(containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool) error {
+	f := w.store(containerService, artifactsDir)
 
 	// convert back the API object, and write it
 	var b []byte
@@ -37,23 +66,22 @@ func (w *ArtifactWriter) WriteTLSArtifacts(containerService *api.ContainerServic
 			return err
 		}
 
-		if e := f.SaveFile(artifactsDir, "apimodel.json", b); e != nil {
+		if e := f.Save(statestore.APIModelKey, b); e != nil {
 			return e
 		}
 
-		if e := f.SaveFileString(artifactsDir, "azuredeploy.json", template); e != nil {
+		if e := f.Save(statestore.TemplateKey, []byte(template)); e != nil {
 			return e
 		}
 	}
 
-	if e := f.SaveFileString(artifactsDir, "azuredeploy.parameters.json", parameters); e != nil {
+	if e := f.Save(statestore.ParametersKey, []byte(parameters)); e != nil {
 		return e
 	}
 
 	if certsGenerated {
 		properties := containerService.Properties
 		if properties.OrchestratorProfile.OrchestratorType == api.Kubernetes {
-			directory := path.Join(artifactsDir, "kubeconfig")
 			var locations []string
 			if containerService.Location != "" {
 				locations = []string{containerService.Location}
@@ -66,38 +94,52 @@ func (w *ArtifactWriter) WriteTLSArtifacts(containerService *api.ContainerServic
 				if gkcerr != nil {
 					return gkcerr
 				}
-				if e := f.SaveFileString(directory, fmt.Sprintf("kubeconfig.%s.json", location), b); e != nil {
+				if e := f.Save(path.Join("kubeconfig", fmt.Sprintf("kubeconfig.%s.json", location)), []byte(b)); e != nil {
 					return e
 				}
 			}
 
 		}
 
-		if e := f.SaveFileString(artifactsDir, "ca.key", properties.CertificateProfile.CaPrivateKey); e != nil {
+		if e := f.Save("ca.key", []byte(properties.CertificateProfile.CaPrivateKey)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "ca.crt", properties.CertificateProfile.CaCertificate); e != nil {
+		if e := f.Save("ca.crt", []byte(properties.CertificateProfile.CaCertificate)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "apiserver.key", properties.CertificateProfile.APIServerPrivateKey); e != nil {
+		if e := f.Save("apiserver.key", []byte(properties.CertificateProfile.APIServerPrivateKey)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "apiserver.crt", properties.CertificateProfile.APIServerCertificate); e != nil {
+		if e := f.Save("apiserver.crt", []byte(properties.CertificateProfile.APIServerCertificate)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "client.key", properties.CertificateProfile.ClientPrivateKey); e != nil {
+		if e := f.Save("client.key", []byte(properties.CertificateProfile.ClientPrivateKey)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "client.crt", properties.CertificateProfile.ClientCertificate); e != nil {
+		if e := f.Save("client.crt", []byte(properties.CertificateProfile.ClientCertificate)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "kubectlClient.key", properties.CertificateProfile.KubeConfigPrivateKey); e != nil {
+		if e := f.Save("kubectlClient.key", []byte(properties.CertificateProfile.KubeConfigPrivateKey)); e != nil {
 			return e
 		}
-		if e := f.SaveFileString(artifactsDir, "kubectlClient.crt", properties.CertificateProfile.KubeConfigCertificate); e != nil {
+		if e := f.Save("kubectlClient.crt", []byte(properties.CertificateProfile.KubeConfigCertificate)); e != nil {
 			return e
 		}
 	}
 
 	return nil
 }
+
+// recordingStore records the keys saved through it in the artifacts of an ArtifactWriter
+type recordingStore struct {
+	statestore.Store
+	writer *ArtifactWriter
+}
+
+func (r *recordingStore) Save(key string, data []byte) error {
+	if err := r.Store.Save(key, data); err != nil {
+		return err
+	}
+	r.writer.artifacts = append(r.writer.artifacts, key)
+	return nil
+}
@@ -0,0 +1,83 @@
+package acsengine
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// WindowsPasswordLength is the length of a generated Windows admin password
+	WindowsPasswordLength = 24
+
+	windowsPasswordLower   = "abcdefghijkmnopqrstuvwxyz"
+	windowsPasswordUpper   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	windowsPasswordDigits  = "23456789"
+	windowsPasswordSpecial = "!@#%^*()-_=+{}:,.?"
+)
+
+// GenerateWindowsPassword returns a random password that meets the Azure complexity
+// requirements for a Windows admin password: it contains lower case, upper case,
+// digit and special characters
+func GenerateWindowsPassword() (string, error) {
+	return generateWindowsPassword(rand.Reader)
+}
+
+func generateWindowsPassword(r io.Reader) (string, error) {
+	classes := []string{windowsPasswordLower, windowsPasswordUpper, windowsPasswordDigits, windowsPasswordSpecial}
+	all := strings.Join(classes, "")
+
+	password := make([]byte, WindowsPasswordLength)
+	// guarantee one character of each class, then fill the rest from all classes
+	for i := range password {
+		charset := all
+		if i < len(classes) {
+			charset = classes[i]
+		}
+		c, err := randomChar(r, charset)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// shuffle so the guaranteed characters are not always at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(r, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(r io.Reader, charset string) (byte, error) {
+	n, err := rand.Int(r, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate a random password: %s", err.Error())
+	}
+	return charset[n.Int64()], nil
+}
+
+// setDefaultWindowsPassword generates the Windows admin password when the cluster has
+// Windows agents and no password was specified. The password is recorded in the
+// output apimodel and parameters, so it is not lost.
+func setDefaultWindowsPassword(a *api.Properties, logger *log.Entry) error {
+	if !a.HasWindows() || a.WindowsProfile == nil || a.WindowsProfile.AdminPassword != "" {
+		return nil
+	}
+
+	password, err := GenerateWindowsPassword()
+	if err != nil {
+		return err
+	}
+	logger.Warnf("apimodel: windowsProfile.adminPassword was not specified, a random password was generated and recorded in the output apimodel")
+	a.WindowsProfile.AdminPassword = password
+	return nil
+}
@@ -0,0 +1,70 @@
+package acsengine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestGenerateWindowsPassword(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		password, err := GenerateWindowsPassword()
+		if err != nil {
+			t.Fatalf("unexpected error generating a password: %s", err)
+		}
+		if len(password) != WindowsPasswordLength {
+			t.Errorf("expected a password of length %d, got %d", WindowsPasswordLength, len(password))
+		}
+		for _, charset := range []string{windowsPasswordLower, windowsPasswordUpper, windowsPasswordDigits, windowsPasswordSpecial} {
+			if !strings.ContainsAny(password, charset) {
+				t.Errorf("expected password %q to contain one of %q", password, charset)
+			}
+		}
+		if seen[password] {
+			t.Errorf("generated password %q twice", password)
+		}
+		seen[password] = true
+	}
+}
+
+func TestSetDefaultWindowsPassword(t *testing.T) {
+	properties := &api.Properties{
+		AgentPoolProfiles: []*api.AgentPoolProfile{
+			{
+				Name:   "linuxpool",
+				OSType: api.Linux,
+			},
+		},
+		WindowsProfile: &api.WindowsProfile{
+			AdminUsername: "azureuser",
+		},
+	}
+	if err := setDefaultWindowsPassword(properties, log.NewEntry(log.New())); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if properties.WindowsProfile.AdminPassword != "" {
+		t.Errorf("expected no password to be generated for a cluster without windows agents")
+	}
+
+	properties.AgentPoolProfiles = append(properties.AgentPoolProfiles, &api.AgentPoolProfile{
+		Name:   "windowspool",
+		OSType: api.Windows,
+	})
+	if err := setDefaultWindowsPassword(properties, log.NewEntry(log.New())); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(properties.WindowsProfile.AdminPassword) != WindowsPasswordLength {
+		t.Errorf("expected a password to be generated, got %q", properties.WindowsProfile.AdminPassword)
+	}
+
+	properties.WindowsProfile.AdminPassword = "existingPassword"
+	if err := setDefaultWindowsPassword(properties, log.NewEntry(log.New())); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if properties.WindowsProfile.AdminPassword != "existingPassword" {
+		t.Errorf("expected an existing password to be kept, got %q", properties.WindowsProfile.AdminPassword)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
 )
@@ -14,6 +15,8 @@ import (
 // SSHCreator represents the object that creates SSH key pair
 type SSHCreator struct {
 	Translator *i18n.Translator
+	// Fs is the filesystem the private key is saved to; when nil it is the local filesystem
+	Fs statestore.Filesystem
 }
 
 const (
@@ -32,6 +35,7 @@ func (s *SSHCreator) CreateSaveSSH(username, outputDirectory string) (privateKey
 
 	f := &FileSaver{
 		Translator: s.Translator,
+		Fs:         s.Fs,
 	}
 
 	err = f.SaveFile(outputDirectory, fmt.Sprintf("%s_rsa", username), privateKeyPem)
@@ -2,7 +2,11 @@ package acsengine
 
 import (
 	"math/rand"
+	"path/filepath"
 	"testing"
+
+	"github.com/Azure/acs-engine/pkg/i18n"
+	"github.com/Azure/acs-engine/pkg/statestore"
 )
 
 func TestCreateSSH(t *testing.T) {
@@ -81,3 +85,17 @@ EPDesL0rH+3s1CKpgkhYdbJ675GFoGoq+X21QaqsdvoXmmuJF9qq9Tq+JaWloUNq
 		t.Fatalf("Public Key did not match expected format/value")
 	}
 }
+
+func TestCreateSaveSSHFilesystem(t *testing.T) {
+	fs := statestore.NewMemFs()
+	creator := &SSHCreator{
+		Translator: &i18n.Translator{},
+		Fs:         fs,
+	}
+	if _, _, err := creator.CreateSaveSSH("azureuser", "_output/mycluster"); err != nil {
+		t.Fatalf("unexpected error creating the SSH key: %s", err)
+	}
+	if files := fs.Files(); len(files) != 1 || files[0] != filepath.Join("_output", "mycluster", "azureuser_rsa") {
+		t.Errorf("expected the private key to be written to the filesystem, got %v", files)
+	}
+}
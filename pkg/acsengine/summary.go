@@ -0,0 +1,96 @@
+package acsengine
+
+import (
+	"encoding/json"
+
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+// GenerationSummary is a machine readable description of a generated cluster, so that
+// pipelines can check the characteristics of the cluster without parsing the logs
+type GenerationSummary struct {
+	OrchestratorType    string `json:"orchestratorType"`
+	OrchestratorVersion string `json:"orchestratorVersion"`
+	// FQDN is the master FQDN, only known when the apimodel has a location or a DNS zone record
+	FQDN       string            `json:"fqdn,omitempty"`
+	Master     *NodePoolSummary  `json:"master,omitempty"`
+	AgentPools []NodePoolSummary `json:"agentPools"`
+	Features   map[string]bool   `json:"features"`
+	Artifacts  []string          `json:"artifacts"`
+	Warnings   []string          `json:"warnings"`
+}
+
+// NodePoolSummary describes the masters or an agent pool in a GenerationSummary
+type NodePoolSummary struct {
+	Name                string `json:"name"`
+	Count               int    `json:"count"`
+	VMSize              string `json:"vmSize"`
+	OSType              string `json:"osType,omitempty"`
+	AvailabilityProfile string `json:"availabilityProfile,omitempty"`
+	StorageProfile      string `json:"storageProfile,omitempty"`
+	OrchestratorVersion string `json:"orchestratorVersion,omitempty"`
+}
+
+// NewGenerationSummary summarizes containerService, which must have had its defaults set
+// by GenerateTemplate. The caller completes the artifacts and the warnings.
+func NewGenerationSummary(containerService *api.ContainerService) *GenerationSummary {
+	properties := containerService.Properties
+	s := &GenerationSummary{
+		AgentPools: []NodePoolSummary{},
+		Features:   map[string]bool{},
+		Artifacts:  []string{},
+		Warnings:   []string{},
+	}
+
+	if o := properties.OrchestratorProfile; o != nil {
+		s.OrchestratorType = o.OrchestratorType
+		s.OrchestratorVersion = o.OrchestratorVersion
+		if k := o.KubernetesConfig; k != nil {
+			s.Features["rbac"] = k.EnableRbac
+			s.Features["aggregatedAPIs"] = k.EnableAggregatedAPIs
+			s.Features["managedIdentity"] = k.UseManagedIdentity
+			s.Features["instanceMetadata"] = k.UseInstanceMetadata
+			s.Features["cloudProviderBackoff"] = k.CloudProviderBackoff
+			s.Features["cloudProviderRateLimit"] = k.CloudProviderRateLimit
+			s.Features["networkPolicy"] = k.NetworkPolicy != "" && k.NetworkPolicy != "none"
+		}
+	}
+	s.Features["aad"] = properties.HasAadProfile()
+	s.Features["windows"] = properties.HasWindows()
+	s.Features["managedDisks"] = properties.HasManagedDisks()
+
+	if m := properties.MasterProfile; m != nil {
+		s.Master = &NodePoolSummary{
+			Name:           "master",
+			Count:          m.Count,
+			VMSize:         m.VMSize,
+			OSType:         string(api.Linux),
+			StorageProfile: m.StorageProfile,
+		}
+		if containerService.Location != "" || m.HasDNSZoneRecord() {
+			s.FQDN = getMasterFQDN(m, containerService.Location)
+		}
+	}
+
+	for _, a := range properties.AgentPoolProfiles {
+		pool := NodePoolSummary{
+			Name:                a.Name,
+			Count:               a.Count,
+			VMSize:              a.VMSize,
+			OSType:              string(a.OSType),
+			AvailabilityProfile: a.AvailabilityProfile,
+			StorageProfile:      a.StorageProfile,
+		}
+		if properties.OrchestratorProfile != nil {
+			pool.OrchestratorVersion = a.GetOrchestratorVersion(properties.OrchestratorProfile)
+		}
+		s.AgentPools = append(s.AgentPools, pool)
+	}
+
+	return s
+}
+
+// JSON returns the indented JSON representation of the summary
+func (s *GenerationSummary) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
@@ -0,0 +1,139 @@
+package acsengine
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/statestore"
+)
+
+func TestNewGenerationSummary(t *testing.T) {
+	cs := &api.ContainerService{
+		Location: "westus2",
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{
+				OrchestratorType:    api.Kubernetes,
+				OrchestratorVersion: "1.7.7",
+				KubernetesConfig: &api.KubernetesConfig{
+					EnableRbac:    true,
+					NetworkPolicy: "none",
+				},
+			},
+			MasterProfile: &api.MasterProfile{
+				Count:     3,
+				DNSPrefix: "mycluster",
+				VMSize:    "Standard_D2_v2",
+			},
+			AgentPoolProfiles: []*api.AgentPoolProfile{
+				{
+					Name:                "agentpool1",
+					Count:               2,
+					VMSize:              "Standard_D2_v2",
+					OSType:              api.Linux,
+					AvailabilityProfile: api.AvailabilitySet,
+					StorageProfile:      api.ManagedDisks,
+					OrchestratorVersion: "1.7.5",
+				},
+				{
+					Name:                "windowspool",
+					Count:               1,
+					VMSize:              "Standard_D2_v2",
+					OSType:              api.Windows,
+					AvailabilityProfile: api.AvailabilitySet,
+				},
+			},
+		},
+	}
+
+	s := NewGenerationSummary(cs)
+	if s.OrchestratorType != api.Kubernetes || s.OrchestratorVersion != "1.7.7" {
+		t.Errorf("unexpected orchestrator %s %s", s.OrchestratorType, s.OrchestratorVersion)
+	}
+	if s.FQDN != "mycluster.westus2.cloudapp.azure.com" {
+		t.Errorf("unexpected FQDN %s", s.FQDN)
+	}
+	if s.Master == nil || s.Master.Count != 3 {
+		t.Errorf("expected 3 masters, got %v", s.Master)
+	}
+	if len(s.AgentPools) != 2 {
+		t.Fatalf("expected 2 agent pools, got %d", len(s.AgentPools))
+	}
+	if s.AgentPools[0].OrchestratorVersion != "1.7.5" || s.AgentPools[1].OrchestratorVersion != "1.7.7" {
+		t.Errorf("unexpected agent pool versions %s and %s", s.AgentPools[0].OrchestratorVersion, s.AgentPools[1].OrchestratorVersion)
+	}
+	if !s.Features["rbac"] || !s.Features["windows"] || s.Features["networkPolicy"] || s.Features["aad"] {
+		t.Errorf("unexpected features %v", s.Features)
+	}
+
+	for location, fqdn := range map[string]string{
+		"chinaeast":    "mycluster.chinaeast.cloudapp.chinacloudapi.cn",
+		"usgovarizona": "mycluster.usgovarizona.cloudapp.windowsazure.us",
+		"West US 2":    "mycluster.westus2.cloudapp.azure.com",
+	} {
+		cs.Location = location
+		if s = NewGenerationSummary(cs); s.FQDN != fqdn {
+			t.Errorf("expected FQDN %s in %s, got %s", fqdn, location, s.FQDN)
+		}
+	}
+
+	cs.Location = ""
+	if s = NewGenerationSummary(cs); s.FQDN != "" {
+		t.Errorf("expected no FQDN without a location, got %s", s.FQDN)
+	}
+}
+
+func TestWriteSummary(t *testing.T) {
+	store := statestore.NewMemoryStore()
+	cs := &api.ContainerService{
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{
+				OrchestratorType: api.Kubernetes,
+			},
+		},
+	}
+	w := &ArtifactWriter{Store: store}
+	if err := w.store(cs, "").Save(statestore.ParametersKey, []byte("{}")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	summary := NewGenerationSummary(cs)
+	summary.Warnings = []string{"a warning"}
+	if err := w.WriteSummary(cs, summary, ""); err != nil {
+		t.Fatalf("unexpected error writing the summary: %s", err)
+	}
+
+	b, err := store.Load(statestore.SummaryKey)
+	if err != nil {
+		t.Fatalf("expected the summary to be saved: %s", err)
+	}
+	written := &GenerationSummary{}
+	if err := json.Unmarshal(b, written); err != nil {
+		t.Fatalf("unexpected error parsing the summary: %s", err)
+	}
+	if len(written.Artifacts) != 2 || written.Artifacts[0] != statestore.ParametersKey || written.Artifacts[1] != statestore.SummaryKey {
+		t.Errorf("unexpected artifacts %v", written.Artifacts)
+	}
+	if len(written.Warnings) != 1 {
+		t.Errorf("unexpected warnings %v", written.Warnings)
+	}
+}
+
+func TestWriteSummaryFilesystem(t *testing.T) {
+	fs := statestore.NewMemFs()
+	cs := &api.ContainerService{
+		Properties: &api.Properties{
+			OrchestratorProfile: &api.OrchestratorProfile{
+				OrchestratorType: api.Kubernetes,
+			},
+		},
+	}
+	w := &ArtifactWriter{Fs: fs}
+	if err := w.WriteSummary(cs, NewGenerationSummary(cs), "_output/mycluster"); err != nil {
+		t.Fatalf("unexpected error writing the summary: %s", err)
+	}
+	if files := fs.Files(); len(files) != 1 || files[0] != filepath.Join("_output", "mycluster", statestore.SummaryKey) {
+		t.Errorf("expected the summary to be written to the filesystem, got %v", files)
+	}
+}
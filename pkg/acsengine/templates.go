@@ -657,7 +657,7 @@ func kubernetes_mountetcdSh() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentcustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x6d\x73\xdb\x36\x12\xfe\xae\x5f\xb1\x61\x6f\xfa\xe5\x0a\xc9\x4e\x6c\xf7\x4e\x1d\xf5\x86\x91\x18\x99\x13\xbd\x95\xa4\x92\xe6\x9a\x0e\x03\x91\x2b\x09\x35\x09\x30\x00\xe8\xd8\x75\xfc\xdf\x6f\x00\xd2\x92\xf5\xea\x24\xbd\xe9\x17\x69\x80\x5d\xec\xf3\xec\x62\xb1\x0b\xf0\xbb\x24\x13\x65\x4a\x12\xc1\xe7\x6c\xd1\x68\x7c\x92\x4c\x63\x3c\x67\x19\xaa\x76\x83\x40\x41\xf5\xb2\x0d\x4e\x0b\x75\xd2\x52\xb7\x4a\x63\x9e\xd6\xff\xad\x54\x24\x57\x28\x9b\x0a\xe5\x35\x4b\xb0\x99\xb6\x92\x0c\xa9\x8c\x73\x51\x72\x1d\x17\x52\x14\x74\x41\x35\x13\x3c\x9e\x67\x74\xa1\x9a\x06\xc0\x69\x00\x14\x28\x73\xa6\x14\x13\x5c\xb5\xc1\x39\xb9\x38\x3b\x33\xb3\xe2\x13\x47\xd9\x06\x47\x0a\xa1\xcd\x38\x11\x5c\x23\xd7\x6d\xf8\xdc\x00\x00\xf8\x2d\xac\x50\x7e\xb7\xa3\xa1\x81\x78\x65\xac\x76\xd4\x92\x4a\x4c\x1b\x5f\xc9\x14\x6f\x30\x89\x95\xa6\x52\xff\x3f\x69\x79\x37\x98\x84\xc6\x68\x67\x6b\xd8\x2a\x95\x6c\xcd\x18\xaf\x89\x40\x4a\x31\x17\x1c\xc8\x25\xcc\xd3\x76\xab\x05\x84\x28\x2d\x24\x5d\x20\x49\x25\xbb\x46\xd9\x11\xd7\x28\x33\x7a\xfb\x1c\x08\x99\xb1\xa2\x73\x77\xf7\x56\xd2\xc2\x55\x6f\xa8\x64\x74\x96\x21\x38\x95\xa1\x97\x92\xa5\x0b\xec\xb2\x54\x3a\xf7\xf7\xdb\x31\xa8\x54\x5a\x15\x56\xf3\x0f\x25\xf8\x37\xbb\x79\x67\x7f\x01\x9c\x8c\x5d\x23\x91\x68\xd8\xa2\xd3\x06\x2d\x4b\xfc\x61\x25\x13\x8b\x9a\xbe\xd3\x06\xc7\xe0\x11\x93\x45\xce\x86\x82\x28\xb4\x72\xda\x6b\x8b\x66\x3e\xa7\x37\x44\xb1\x3f\x8d\x41\xe7\xfc\x24\x5f\x2d\x78\x90\x59\x2b\x46\xe6\xd4\x82\xfb\x46\xf5\xbb\xe5\xf0\x55\x39\x43\xc9\x51\xa3\x6a\x25\x28\xb5\x6a\x25\xb4\x99\x48\x7d\xd8\x6b\xe4\x89\x48\x19\x5f\xb4\xc1\x99\x51\x85\x17\x5f\x14\x8a\x9d\xad\x48\x68\x17\xa5\x66\x73\x96\x50\x8d\x7b\xf6\x61\x87\x16\x2d\x98\xc9\x44\x94\x7f\x07\xbb\x15\xd8\x57\x92\x4c\x32\x86\x5c\xff\x2d\xf1\xb3\x48\x87\xe9\x5d\x53\xd9\xca\xd8\xcc\x52\xcc\x50\xdb\xff\xaa\x58\x7d\x73\x3e\xd3\x82\xbd\x41\x69\x16\xb5\xe1\xfa\xd4\x4e\x5d\x31\x9e\xb6\xa1\x5b\x15\x41\x33\x91\x64\xa5\xd2\x28\x55\xdb\x8e\x08\x70\x9a\x63\x1b\x32\x91\xd0\xac\x16\xd5\xd9\x58\x8f\xda\xab\xac\x4d\xd6\xae\x10\x5a\xea\xa5\x90\x4c\xdf\xb6\xe1\x58\x8e\xae\xd6\x56\x7b\xd5\x86\xa5\xd6\x85\x6a\xb7\x5a\xbb\xe1\x5a\x5b\x70\x27\x7e\x68\xd5\xfd\x89\x73\x7f\xdf\x3e\x3b\x7b\x61\xcd\x94\x6a\x87\x75\x15\xe2\x1a\xc4\xc8\x1f\x91\xb5\x22\xf2\x88\xf3\x41\xa6\xab\x8c\xd8\x5e\x7c\x85\x87\xdd\xab\x16\x5d\xe1\x6d\x15\x54\xb3\x0f\x37\x7a\x45\xaf\x1e\x3f\xa6\x53\x05\x73\x5f\xa0\x6b\xea\x9b\xde\xec\x6e\x4b\x6d\xb3\xc2\x2b\xa5\xb4\xee\xd5\x38\x7b\x15\x8f\xf7\x0e\x9b\x6e\x3a\x23\x78\xa3\x25\x4d\xf4\x43\x13\xf9\xf6\x96\x31\xe5\x4c\x57\xfd\xa2\x87\x2a\x91\xac\x30\x3d\xb2\xf3\xba\x82\x81\x1a\x86\x09\x6e\x55\x02\xfc\x58\x32\x89\xaa\xb3\xd9\xc2\xac\xcc\x9d\x6b\x94\xfb\x04\x5d\xc1\x53\x66\x4c\x4c\xa8\x5e\x7a\x37\x4c\x69\xd5\x79\x66\x7b\x90\x75\xdf\x76\xa2\xda\xad\xc6\x9e\x36\x16\xb1\x1c\x45\xa9\x6d\xeb\x0a\x31\xe9\x9c\xd4\x4c\x6c\xbf\xec\x98\xb2\x4e\x59\x56\x4a\x7c\x3c\x6d\xf4\xce\xd5\x66\xdb\x9b\x48\xec\x58\xac\xfc\x2a\x65\x12\x48\x01\x2d\x9d\x17\x0f\xc8\x29\x93\x7b\xd4\xb7\x1a\x65\x51\x66\xd9\x9e\x92\xb1\x4e\xb3\xcb\xdb\x02\xa5\x19\x86\x05\x26\xa6\x70\x3c\x69\x52\x96\x1c\x08\x91\x39\x90\xeb\x6d\x3e\xed\x96\x28\xf4\xa3\xf1\x57\x21\x83\x05\x99\x51\xb5\x04\x92\x80\x93\x14\xd0\x5a\x3e\xa8\xc0\x96\xe1\x96\x73\x28\x52\x3b\x9c\x1e\x1b\xd9\xbf\x83\x5b\x57\x0d\x23\x4a\x96\xb9\x48\x81\xfe\xf3\xe6\xd0\x9a\x6a\xd7\x7d\xae\x34\xcd\xb2\x6a\xd7\xdf\x52\xae\x31\x7d\x79\xdb\xc9\xcb\x4c\x33\x62\x8e\x5a\x53\x53\xb9\xc0\x9d\x03\x92\xe2\x9c\x96\x99\x7e\x28\xc8\xdf\x7c\x12\x5e\x4f\x5f\x7a\x03\x2f\x8a\xbb\x83\x69\x18\x79\x41\xdc\x1b\x85\x7b\x6e\x3a\x06\xa5\x37\x0a\xeb\x0c\xb5\xa5\x6e\x63\xb5\x3b\xf1\xe3\xd0\x0b\xde\x78\x41\xd8\xf9\x0b\x55\xf3\xc1\x9c\x3f\x74\xfb\xde\x01\x1a\x47\x52\xee\x61\xf9\xc8\x8b\xde\x8e\x83\xd7\xf1\x64\x30\xed\xfb\xa3\x8e\x51\xe3\xa8\x37\x54\x86\xee\xaf\xf1\x64\xdc\x0b\x3b\xa7\xa7\xd5\xc9\xea\x8d\xbb\xaf\xbd\x20\x1e\x4f\xa2\xb0\xba\x38\x76\xa7\x61\x34\x1e\xc6\xdd\x61\xaf\xda\x4e\x73\xcd\xda\x30\x11\x78\x7d\xdf\x86\x2c\xec\x5e\x7a\xbd\xe9\xc0\x7d\x39\xf0\x3a\x3b\x5a\xa3\x71\xcf\x8b\x07\xee\x4b\x6f\x60\xe2\x0a\x7d\xd4\xaf\x57\x4e\x0c\xe8\x0c\x33\x05\x4d\xd8\xe2\x3f\x19\xf7\x62\x7f\xf4\x2a\x70\xe3\xee\x78\x14\xb9\xfe\xc8\x0b\xbe\x20\x24\x13\x91\xfa\x7c\x2e\x69\x57\x70\x4d\x19\x47\xb9\x37\x34\x86\x4e\x18\xb9\xd1\x34\x8c\xa7\x93\x9e\x1b\x79\xf1\xab\xc0\xfb\x65\xea\x8d\xba\xef\x8e\x5a\x1f\x89\x14\x43\x4d\x75\xa9\xa6\x45\x4a\x35\xbe\x92\xf8\xb1\x44\x9e\xdc\x3e\x46\x88\xbb\x51\x30\x88\x87\xfd\xa0\xc2\x19\x8e\x47\x7e\x34\x0e\xe2\x7e\xe0\x76\xbd\x78\xe2\x05\xfe\xb8\x77\x14\xa4\xab\x65\x36\x5c\x48\x83\x35\x14\x9c\x69\x21\xfb\x92\x26\x38\x41\xc9\x44\xba\x1f\xc8\xc4\xca\x7b\xe3\x77\x23\x7f\x3c\x8a\x23\x7f\xe8\x8d\xa7\xd1\x97\x60\x4c\x44\xea\x5d\x33\x5b\xe3\xeb\x52\xbb\xdf\x7e\x30\x9e\x46\x5e\x1c\x78\xdd\xf1\xa8\xeb\x0f\x7c\xd7\xe2\x7c\xb9\x2b\x81\x28\x35\x06\xe6\xaa\x94\xb0\x8c\xd9\x97\xd8\xae\x37\xab\x94\x8f\xfb\xdd\xf8\xd2\xef\x5f\xc6\xd1\x65\xe0\x85\x97\xe3\xc1\x3e\x8c\x45\xb2\x64\x8b\xa5\x5e\x4a\x54\x4b\x91\x1d\x36\x34\x18\xbf\x7d\xc2\x4e\x26\x3e\x6d\x98\xb9\xbb\x63\x73\xf0\xd5\x3a\x41\xeb\xfb\x59\x1f\xc1\x39\x6d\x5e\x34\x4f\x76\xb3\x69\x14\x0f\xdd\xf0\x97\xa9\x17\xb8\x3d\x2f\xee\xfa\xbd\xa0\x43\x08\x17\x9c\xe4\x54\x7d\x2c\x51\xd2\x14\x49\xc2\x52\xf9\x44\x6a\xf1\xe1\x4a\xfd\xe1\x1d\xf5\x18\xe6\x95\xe7\x46\xd3\xc0\x8b\xfb\x6e\xe4\x85\x1d\x42\xe6\x48\x75\x29\x91\x2c\xa8\x46\xd5\x71\x93\x04\x33\x94\x54\x0b\xa9\x1e\xce\xdf\x21\x4f\x22\x96\xa5\xb5\x33\x37\x3b\x28\xfe\xaf\xf1\xd9\x8b\x1f\x4f\xce\xe2\xd3\x0e\x21\xc9\x42\x8a\xb2\x50\xa4\x40\x49\x3e\x0a\xd5\x99\xd3\x4c\xe1\x01\xfd\xe7\x1d\x42\x90\xcf\x85\x4c\x90\x70\x91\x22\xa1\x99\xa9\xf6\xda\x38\xda\x39\xb0\xe6\x45\xc7\x71\x2c\x51\xe4\xa9\x0d\x7d\xf5\xff\xf4\x35\x28\xc3\x2f\xb8\xfe\xac\x1f\x05\x8b\x3f\x59\x71\xac\x0b\x3c\x7b\x36\x63\x9c\xca\xdb\xad\x76\x60\x8a\xb9\xdf\xf5\xe2\x97\x17\x67\x71\xff\xbf\xfe\x24\x0e\xa3\xe0\x31\x39\xd3\x4a\xe9\x9f\xa5\xc4\x56\xf2\x50\x6e\xd4\x9a\xde\x72\x0f\xb3\x1f\xcf\xcf\xbf\xa0\x1d\x7d\xf7\x6c\xd5\xc1\x1b\x0f\xf9\xf8\x66\xe4\x45\x3e\xd7\xb8\x90\x54\x63\x5a\xef\xda\x77\x10\x8e\xdc\x08\x44\xa9\x67\xa2\xe4\x29\x68\x49\xe7\x73\x96\xc0\x5c\x8a\x1c\x0a\x91\x2a\xd0\x02\x52\x54\x9a\x71\x7b\xea\x94\x51\x55\x2c\x45\x10\x73\x30\x16\x9b\xd6\x0c\x2b\xec\x2e\x29\x20\x1a\x38\xd5\x40\x5c\x98\x8c\xc3\xc8\x9c\x7a\x7f\xd4\x07\x92\x03\x2b\x24\xe5\x0b\x84\x67\x40\x48\xaa\x34\xa9\x46\xa7\x17\xff\x6a\x5e\xbc\x68\x9e\x3e\xff\x77\xf3\xf4\xc2\xa8\xd1\x34\x95\xfa\xb6\x58\xeb\xd9\x81\x6d\xfa\x66\x2a\xdd\x73\x8d\xb9\xe6\xa8\xeb\x74\x07\xf2\x07\xac\x0f\xd2\x3a\x1b\x0c\x45\xbc\x61\x1a\x4e\x9e\x0c\x7e\x21\xc5\x35\x33\xd1\x3e\x10\xfe\xbf\x98\x18\xbb\xf4\x57\x80\xa1\xbd\x3f\xdb\x07\xa3\x2c\x79\x92\xa7\xed\x06\x01\x4c\x96\x02\x3e\x98\x4e\xf1\xe1\x87\x0f\x4b\xa1\xb4\x79\x23\x7c\xf8\x01\xec\x45\xb5\x52\xfb\xf9\x67\xeb\x49\x0e\x0d\x02\xb4\xd0\x24\xa7\xf2\x0a\x4c\x2d\x82\x4f\x34\x63\xbc\xbc\xa1\x0b\xe4\xfa\xee\x6e\xa3\x67\xba\x66\x6e\x22\x71\x85\xfe\x8e\xe6\x19\x34\xef\xef\x8f\x60\x16\x12\x69\xa1\x4b\xdb\xb7\xb6\x41\x17\xa8\xa1\x92\x1c\x33\x20\x94\x3e\x6a\x81\x55\x97\x38\x20\xb7\x76\x4a\x4b\xca\x55\x21\xa4\x26\xf6\x32\x04\x09\x7d\xfc\xb6\x53\xc0\xe7\x8a\x24\x22\xcf\x05\x3f\x02\x4a\x0b\x5d\x9b\x7d\x8c\x58\x55\x02\xfb\x48\xe1\x76\x1f\x64\x91\xcc\x18\x4f\x0f\x88\x88\xd2\x54\x6f\x0a\xed\x0e\xec\x5d\xb6\x92\xac\x56\x1d\x89\x68\x75\x93\xdf\x62\xd8\x20\x30\x17\x12\x18\x30\x0e\xa7\xf0\x1c\x5e\xc0\x19\x9c\xff\x04\xa9\x30\x8f\xc0\x0c\x08\xc9\xe9\x0d\xd1\x2c\x47\xb8\x38\x01\x32\x57\xe1\x60\xf5\xc8\xa6\x85\xae\x5f\x51\x36\xb5\x31\x5d\x60\x93\xa3\x6e\x2d\x8a\x05\x7c\xb6\x51\xbd\xc2\x5b\x73\xcc\x80\xfc\x04\xbf\xc1\x3f\xfe\x03\x04\x3f\xc2\x09\xfc\x0e\xdf\x7f\x0f\x33\x89\xf4\x0a\x3e\x7f\x06\x95\x21\x16\x15\x24\x5f\xed\xa8\x93\xe2\x6c\x4f\x02\x57\x70\x1e\x5f\x30\x8e\x3d\xf1\x89\x67\x82\xa6\x01\x16\xc2\x9c\xc7\x72\x56\x72\x5d\x92\x1b\xe4\x8c\x66\x90\x53\xc6\x1d\xf8\x0c\xaa\x4c\x05\x68\xc4\xea\x9d\x4d\x0b\xdd\x52\xa2\x94\x09\xaa\x66\xc6\x94\x6e\xa6\x0f\xdf\x38\xcd\xa8\x41\xc0\xb1\xe8\xef\x9d\x09\x4d\xae\xe8\x02\xdb\x50\x89\x09\x5a\xc8\xf7\x7c\xc2\x78\x1b\xae\xab\xce\xf4\x04\xbf\xba\x7f\x39\xf7\xf7\x76\x19\x99\x48\x56\x7f\xd1\x38\x3f\x3f\x79\xcf\xdf\x3b\xf0\xf3\x9a\x54\x21\x71\x8e\x12\xb9\x21\xb6\xe2\x64\x26\x9d\x7d\x49\xbf\x27\x87\x71\x56\x55\xc5\xfd\xd2\x0d\x2f\x9e\x38\x35\x87\xb2\x64\x9d\x74\xb2\x7a\xbb\xd6\x56\x1b\x04\xd6\x0f\xd5\xad\x8f\x19\x39\xe5\x6c\x8e\x4a\x1b\x5a\xe6\x65\x64\x9e\x57\x84\xf6\xeb\x95\x7b\x02\x68\x94\x0c\x17\xe7\x68\x75\x98\x04\x1e\x71\x27\x11\x09\xdf\x85\x91\x37\xec\x91\x9e\xeb\x0f\xde\x3d\xa2\x5a\xbd\xde\xd8\xcc\x86\x96\x16\xba\x59\x37\xe5\x66\x4a\x59\x76\x7b\xcc\xf0\x38\x8c\x8e\x5a\x5e\x15\xbd\x92\xef\x94\xbd\x8d\x40\xec\x2b\xf8\x26\xed\xb5\x28\x93\xe5\x7e\x71\xab\xaa\xb1\xcd\x44\xe4\x45\x86\x47\xab\x1b\xf2\x74\xbb\x20\xff\x2f\x00\x00\xff\xff\x61\xdc\xf0\x30\x8f\x18\x00\x00")
+var _kubernetesagentcustomdataYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x58\x5b\x73\xdb\x36\x16\x7e\xf7\xaf\x80\x99\x9d\xbe\x34\x90\xec\xf8\xd2\x5d\x75\x94\x1d\x45\xa2\x65\x8e\x75\x5b\x52\x76\x9a\x36\x1d\x06\x22\x21\x09\x35\x49\x30\x00\xe8\xd8\x71\xfc\xdf\xf7\x00\xa4\xa8\x1b\x25\xbb\x69\xa7\x0f\x91\x03\xe0\xe0\xdc\xf1\x9d\x73\xf8\x2a\x88\x78\x16\xe2\x80\x27\x53\x36\x3b\x38\xf8\x22\x98\xa2\xfe\x94\x45\x54\x36\x0e\x30\x4a\x89\x9a\x37\x90\x55\xa7\x2a\xa8\xcb\x07\xa9\x68\x1c\x16\x7f\xeb\x21\x0f\x6e\xa9\xa8\x49\x2a\xee\x58\x40\x6b\x61\x3d\x88\x28\x11\x7e\xcc\xb3\x44\xf9\xa9\xe0\x29\x99\x11\xc5\x78\xe2\x4f\x23\x32\x93\x35\x2d\xc0\x3a\x40\x28\xa5\x22\x66\x52\xc2\x81\x04\xc6\x47\xe7\xa7\xa7\x7a\x97\x7f\x49\xa8\x80\xb5\xe0\x5c\xe9\x35\x50\x2b\x9a\xa8\x06\xfa\x06\x0b\x84\x7e\xf3\x72\x29\xbf\x9b\x55\x5f\x8b\xb8\xd0\x5c\x9b\x72\x4e\x04\x0d\x0f\xfe\xa4\xa6\xf4\x9e\x06\xbe\x54\x44\xa8\xbf\x53\x2d\x1b\xb8\x7a\x9a\x69\x73\x63\x59\xcf\xa4\xa8\x4f\x58\x52\x28\x82\x42\x42\x63\x9e\x20\x7c\x89\xa6\x61\xa3\x5e\x47\x18\x4b\xc5\x05\x99\x51\x1c\x0a\x76\x47\x45\x93\xc3\x4f\x44\x1e\xde\xc0\xc9\x84\xa5\xcd\xc7\xc7\xf7\x82\xa4\x2d\x79\x43\x04\x23\x93\x88\x22\x2b\x67\xf4\x4e\xb0\x70\x46\xdb\x2c\x14\xd6\xd3\xd3\xa6\x0f\x72\x92\x7a\x2e\xab\xf6\x87\xe4\xc9\x77\x9b\xf9\x68\x7e\x11\xb2\x22\xd0\x0e\x0b\xaa\xb5\xa5\x56\x03\x29\x91\xd1\xd7\xe5\x19\x9f\x15\xea\xc3\x89\xa5\xe5\x61\x9d\x45\xd6\x1a\x01\x4f\x95\x84\xe3\x92\xa3\xde\x8f\xc9\x3d\x96\xec\xab\x66\x68\x9d\x1d\xc5\xe5\x85\xc5\x99\xe1\xa2\xcf\xac\xe2\xe0\xe9\x20\xff\xdd\x30\xf8\x36\x9b\x50\x91\x50\x45\x65\x3d\xa0\x42\xc1\x2f\xa9\x05\x42\xed\xb6\x9a\x26\x01\x0f\x59\x32\x83\xad\x09\x91\xf4\xfc\x45\xae\xd8\x0a\x45\x40\xda\x20\x8d\x4d\x59\x40\x14\xad\x88\xc3\x96\x5a\x24\x65\x3a\x13\x21\x21\xff\x01\xed\x4a\x61\x7f\x52\xc9\x20\x62\xc0\xf7\x1f\xf1\x9f\x91\xb4\x5b\xbd\x3b\x22\xea\x11\x9b\x18\x15\x23\xaa\xcc\xdf\x1c\xac\xbe\x3b\x9f\xc1\x29\x37\x54\xe8\x4b\x0d\x74\x77\x6c\xb6\x6e\x59\x12\x36\x50\x3b\x07\x41\xbd\x11\x44\x19\x60\x87\x00\x10\xd4\x2b\x8c\x12\x12\xd3\x06\x8a\x78\x40\xa2\xe2\xa8\xc8\xc6\x62\xd5\x28\xb3\x36\x58\x9a\x82\x49\xa6\xe6\x1c\x10\xf5\xa1\x81\xf6\xe5\x68\x79\x37\x8f\x55\x03\xcd\x95\x4a\x25\x40\xc3\xb6\xbb\x96\x1c\x5a\x23\xc7\x33\xe4\xce\x08\x5c\xd6\x38\x3d\x3d\x31\x6c\x32\xb9\xa5\x75\xee\xe2\x42\x88\x3e\x5f\x51\xd6\x1c\xe1\x15\x9d\x77\x6a\x5a\x66\xc4\xe6\xe5\x5b\xba\xdb\xbc\xfc\x12\x50\xe4\x4e\xd5\x71\xb8\x57\xa5\x7a\xc5\x7a\x55\x9d\xdc\x99\x55\x8e\x2e\x54\x5f\xb7\x66\x3b\x2c\x05\xcf\x5c\x5e\x26\x84\x31\xaf\x90\x53\x49\xb8\xbf\x76\x98\x74\x53\x11\x06\x4a\x41\x02\xb5\x28\x22\xdf\x5f\x32\xae\x13\xa6\xf2\x7a\xd1\xa1\x32\x10\x2c\xd5\x35\xb2\x79\x95\x8b\x41\x85\x18\xd8\x32\x24\x2e\xfd\x9c\x31\x00\xdc\xe6\x7a\x09\x33\x67\xad\x29\x18\x51\x75\x00\x59\x1c\x32\xcd\x62\x04\x66\xd9\xf7\x4c\x2a\xd9\x3c\x34\x35\xc8\x98\x6f\x2a\x51\x61\xd6\x41\x45\x19\x1b\xb3\x98\xf2\x4c\x99\xd2\xe5\xd1\xa0\x79\x54\x68\x62\xea\x65\x53\xc3\x3a\x61\x51\x26\xe8\xea\xb6\xa6\x3b\x93\xeb\x65\x6f\x24\x68\xd3\xc8\x8a\x6f\x43\x26\x10\x4e\x51\x5d\xc5\xe9\x42\x32\x6c\x55\x90\x6f\x14\xca\x34\x8b\x22\x80\x8c\x2e\x55\xad\x19\xf8\xf0\xaa\x4c\xaf\xcb\x07\x70\xbe\xe6\xe4\xa5\x34\x40\xb5\xa7\xa7\xe7\x79\x89\x0c\x2a\x2e\x16\x31\xc2\x77\x9b\x8a\x34\xea\x50\x97\x56\xd6\x2f\x13\x89\x0c\x77\x40\xbe\x39\xc2\x01\xe0\x18\xd8\x37\x5f\xd0\xa0\x0d\x8e\x75\x6b\x97\x6f\xb6\x94\x59\x65\x52\x1d\xb3\x35\x4e\x39\x9b\x60\x1e\xf3\x10\x91\x1f\xef\x77\xdd\xc9\xe3\xec\x24\x10\xad\x28\xca\xe3\xfc\x9e\x40\x62\x86\xef\x1e\x9a\x71\x16\x29\x86\xf5\xe3\xaa\x01\xc7\x19\xdd\x7a\x12\x21\x9d\x12\xa0\x59\x40\xf0\x77\xe7\xfe\xd5\xf5\x3b\xbb\x67\x8f\xfd\x76\xef\xda\x1b\xdb\xae\xdf\x19\x78\x15\xbd\x8d\x96\x02\x27\x45\x4e\x1a\x70\x5b\xbb\x0d\xb8\xe7\x7b\xb6\x7b\x63\xbb\x5e\xf3\x2f\xe0\xe4\x82\x9d\xd3\x6f\x75\xed\xe6\xcb\x93\x6c\x71\x6f\x60\x8f\xdf\x0f\xdd\x2b\x7f\xd4\xbb\xee\x3a\x83\xa6\xa6\x83\x5b\x6b\x24\xfd\xd6\x2f\xfe\x68\xd8\xf1\x9a\xc7\xc7\xf9\x23\xea\x0c\xdb\x57\x60\xf7\x70\x34\xf6\xf2\x1e\xb1\x0d\x8e\x18\xf6\xfd\x76\xbf\x93\xc7\x51\x77\x54\x6b\x2c\x5c\xbb\xeb\x18\x5f\x79\xed\x4b\xbb\x73\xdd\x6b\xbd\xeb\xd9\xcd\x2d\xaa\xc1\xb0\x63\xfb\x70\x66\xf7\xb4\x43\x11\x98\xb2\xb4\xa2\x47\x20\x68\x12\xd5\xd0\x86\xfe\xa0\x98\xef\x0c\x2e\xdc\x96\xdf\x1e\x0e\xc6\x2d\x67\x00\x42\x16\xbe\xd8\xed\xcc\x11\x0f\x9d\x64\x2a\x08\x60\x8c\x22\x0c\xa2\xad\x7d\xb3\x19\x21\xa3\x8e\x37\x6e\x8d\xaf\x3d\xff\x7a\xd4\x69\x8d\x6d\xff\xc2\xb5\xff\x77\x6d\x0f\xda\x1f\xf6\x72\x1f\xf0\x90\x42\x56\xab\x4c\x5e\xa7\x21\xd4\xa2\x0b\x01\xf8\x07\x6d\xc6\xc3\xaa\x04\xbf\x3d\x76\x7b\x7e\xbf\xeb\xe6\x72\xfa\xc3\x81\x33\x1e\xba\x7e\xd7\x6d\xb5\x6d\x7f\x64\xbb\xce\xb0\xb3\x57\x48\x5b\x89\xa8\x3f\x13\x5a\x56\x9f\x03\x18\x73\xd1\x05\xc8\xa5\x23\x2a\x18\x0f\xab\x05\x69\x5f\xd9\x37\x4e\x7b\xec\x0c\x07\xfe\xd8\xe9\xdb\xc3\xeb\xf1\x4b\x64\x80\xb7\x6c\x48\x62\x8d\xc5\x05\xaa\x56\xf3\x77\x81\x9f\x0d\xb1\x86\x48\xb4\x9d\x9e\xd3\x32\x72\x5e\x6e\x8a\x0b\x8c\xa9\xab\xbb\xa2\x80\x45\xcc\x0c\x5d\xdb\xd6\x94\xb9\xee\x77\xdb\xfe\xa5\xd3\xbd\xf4\xc7\x97\xae\xed\x5d\x0e\x7b\x55\x32\x66\xc1\x9c\xcd\xe6\x6a\x0e\xb5\x67\xce\xa3\xdd\x8c\x7a\xc3\xf7\xcf\xf0\x89\xf8\x97\x35\x36\x8f\x8f\x6c\x8a\x1c\xb9\xf1\xd6\x8a\x7e\xac\x4b\x21\x51\xad\xe3\xda\x79\xed\x68\x3b\xa9\x06\xf0\xa0\x3c\xc8\x22\xb7\x05\x61\x6f\x3b\x1d\xb7\x89\x71\x02\xf5\x28\x26\x12\xb2\x44\x90\x90\xe2\x00\x66\xa1\x67\x32\x2c\xe9\x97\xe4\x8b\xc9\x69\x55\xcc\x85\x0d\x69\xeb\x82\x6d\x90\xb5\x1e\x08\x98\x52\x48\x47\x41\x31\xcc\xb2\x50\x86\x5b\x41\x00\x08\x28\x08\x24\x8d\x5c\x3c\xc3\xbd\x06\x8d\x59\x14\x96\x36\xdd\x6f\x09\x73\x7e\xf1\x4f\x4f\x7e\x3a\x3a\xf5\x8f\x41\x54\x30\x13\x3c\x4b\x25\x06\xd4\xc1\x9f\xb9\x6c\x4e\x49\x24\xe9\x0e\xfa\x37\x40\x4f\x93\x29\x17\x01\x05\x1f\x80\xe5\x00\xeb\x00\xfa\x4a\xdb\xdb\xdc\x71\xe7\xa4\x69\x59\x46\x5f\x9a\x84\x26\x10\xf9\xdf\xe7\xfb\x1f\xc0\xfc\xe7\xfb\x9e\xe5\x34\x30\xfb\xca\xd2\x7d\xc5\xe0\xf0\x10\xa0\x8e\x88\x87\x8d\xaa\xa0\x31\xdd\x81\x27\xfc\xee\xfc\xd4\xef\xfe\xea\x8c\x00\x41\xdc\x55\xe5\x74\x45\x25\x5f\x21\x16\xf5\x60\x01\x3e\x72\xa9\xde\xbc\x42\xb3\x9f\xce\xce\x5e\x50\x95\x5e\x1d\x96\x85\xfc\x60\x91\x9d\x37\x80\xee\x0e\x50\xcd\x20\xd4\x34\x2c\xa2\xf6\x0a\x79\x83\xd6\x18\xc1\x63\x9b\xf0\x2c\x09\x61\xfc\x25\x53\xe8\x99\xd1\x54\xf0\x18\xa5\x3c\x94\x48\x71\x14\x42\x43\x04\xd6\xe9\x37\x28\x35\xa9\x64\x10\x7f\x3e\x45\x9a\x63\xcd\xb0\x81\x8e\x4f\x47\x49\x22\xac\xa0\x7d\x55\x08\xb7\xd0\x68\x08\xb6\x02\x06\x38\x83\x2e\xc2\x31\x50\x08\x92\xcc\x28\x3a\x84\x86\x25\x94\x0a\xe7\xab\xe3\xf3\x7f\xd7\xce\x4f\x6a\xc7\x6f\xfe\x53\x3b\x3e\xd7\x64\x24\x0c\x85\x82\x1a\x55\xd2\x99\x85\xa9\xfd\x7a\x2b\xac\x18\xb6\xee\x20\x37\x8b\xac\x47\xf8\x0f\xb4\x7c\x4f\xcb\x6c\xd0\x2a\xd2\x7b\xa6\xd0\xd1\xb3\xce\x4f\x05\xbf\x63\xda\xdb\x3b\xdc\xff\x17\x13\x63\x5b\xfd\x52\xa0\x67\x1a\x67\x33\x29\x42\x5f\x17\xc4\xa1\xfe\x56\x45\x83\x39\x47\x9f\x74\xdd\xf8\xf4\xfa\xd3\x9c\x4b\xa5\x87\x83\x4f\xaf\x91\xe9\x50\x73\xb2\xb7\x6f\x8d\x25\x31\x02\x72\x92\x2a\x80\x0e\x71\x8b\x34\x32\xa1\x2f\x24\x62\x49\x76\x4f\xf4\x2b\x36\xcd\xc0\xf2\x29\x9b\x97\x0d\x1d\x5b\x29\xfd\x03\x89\x23\xd3\x0f\xec\x96\x99\x0a\x0a\xfc\x33\x53\xc5\x36\x85\x42\x87\x85\xf2\x93\x7d\x0c\xe0\xbf\x7b\x39\xb0\xbc\x97\x43\xf8\xc1\x6c\x41\x32\x26\x32\xe5\x42\x61\xd3\x13\xa1\x80\xac\x0e\x75\x12\x25\x53\x09\x63\x50\x1c\xc3\x60\xb1\x5b\x28\x30\x2a\xd8\xae\x4a\xcc\x91\xc0\x4c\x27\x89\x89\x83\x48\x03\x08\x54\xb8\xe3\x08\x03\x03\xb5\x7e\x68\x22\x50\x79\xad\x3c\x29\x6f\xed\xf1\x68\xde\xc9\x6f\x68\x08\x57\x00\x06\x11\x03\x87\xa0\x63\xf4\x06\x9d\xa0\x53\x74\xf6\x33\x0a\xb9\x9e\xfe\xc0\x3d\x58\x7f\x43\x52\x50\x7d\xd1\xf9\x11\xc2\x53\xe9\xf5\xca\xe9\x1a\xcc\x2d\xc6\x27\x93\xda\x34\x9c\xd1\x1a\x44\xbc\x3e\x4b\x67\xe8\x9b\xf1\x2a\x0c\xae\xfa\x99\x21\xfc\x33\xfa\x0d\xfd\xeb\xbf\x08\xd3\xcf\xe8\x08\xfd\x8e\x7e\xf8\x01\x4d\x20\xc2\xb7\xe8\xdb\x37\x24\x23\x4a\xd3\x5c\x64\x52\x46\xd4\x0a\xe9\xa4\x22\x81\x73\x71\x76\x32\x83\x07\xd4\x81\x77\x10\x71\x12\xba\x34\xe5\xfa\x3d\x66\x93\x2c\x51\x19\xbe\xa7\x09\x83\x07\x1c\xc3\x1b\xb3\x40\x0b\x99\x81\x25\x8a\xd2\x7c\xc0\x06\x9d\xea\x92\x67\x80\xfa\xb2\x16\xc1\x60\x57\x0b\x17\x1f\x37\xf5\x0a\x84\x5b\x46\xfa\x47\x6b\x44\x82\x5b\xc8\xe5\x06\xca\x8f\xa1\x56\x68\x91\x1f\x93\x11\xd3\xdf\x3c\xf2\xda\xf4\x8c\x7e\x45\x05\x03\xd5\xcc\x35\x3c\x82\x8e\x22\xff\x94\x71\x76\x76\xf4\x31\xf9\x68\xa1\xb7\x4b\xa5\x20\x3a\x53\x0a\xb3\xb6\x56\xac\xd4\x49\x6f\x5a\x55\x49\x5f\x91\xc3\x74\x92\xa3\x62\xf5\xe9\x9a\x15\xcf\xbc\x9a\x5d\x59\xb2\x4c\x3a\x91\x0f\xad\x05\x57\x38\x5a\x4e\xa8\x1b\x5f\x31\x62\x92\xb0\x29\x50\x6b\xb5\xf4\x80\xa4\xa7\x2c\x4c\xba\xc5\xcd\x0a\x07\x6a\x22\xad\x8b\xb5\x17\x1d\x46\xae\x8d\x5b\xa3\x31\xf6\x3e\x40\x6f\xdf\xef\xe0\x4e\xcb\xe9\x7d\x58\x51\x35\x1f\xe2\xd8\xc4\xb8\x16\xfe\xd5\x8a\xa2\x5c\x0b\x61\xf4\x7e\xd8\xc7\x18\x0a\xc9\x5e\xce\x25\xe8\x65\xc9\x16\xec\xad\x39\xa2\x0a\xf0\x75\xda\x2b\x9e\x05\xf3\xea\xe3\x7a\x8e\xb1\x35\x80\x99\x14\x6a\xf2\xbe\x38\x41\xad\xd9\x04\xe4\xff\x03\xb8\x02\xea\x92\x88\x18\x00\x00")
 
 func kubernetesagentcustomdataYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -677,7 +677,7 @@ func kubernetesagentcustomdataYml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5d\x6f\xdb\xb8\xd2\xbe\xde\xfc\x0a\x42\x28\xaa\x18\xf0\x47\xd2\x6e\xf1\x02\x05\xde\x02\x69\x92\xb6\x3e\xad\x5b\x9f\xb8\xe9\xb9\xe8\xe6\x82\x16\xc7\x32\x11\x89\x54\x49\xca\x4d\x6a\xf8\xbf\x1f\x90\xfa\xa4\x44\xd9\x6e\x9b\x74\xb3\x67\xb7\x37\x8d\xc5\xe1\x68\xf8\xf0\x99\x0f\x0e\x85\x10\x42\xeb\x03\x64\xfe\x79\x38\xa1\x9f\x40\x48\xca\x99\xf7\x1c\x79\x9f\x57\x58\x50\x3c\x8f\x40\x1e\xfa\xd5\xc8\x19\x2c\x70\x1a\x29\xbf\x77\xe5\xf5\x8b\x79\x01\x4f\x6e\xbd\xe7\xa5\x1e\xf3\x24\x65\xca\x28\x91\xe9\xfc\xb0\xa6\x68\xbd\x1e\xbe\xc7\x31\x6c\x36\xa7\x5a\xc2\xef\xf5\x91\x6b\xf0\xc3\x62\x21\x41\xf9\xbd\xda\x4b\x10\xf2\x18\x8e\x41\xeb\x8c\x38\x4f\xbc\xfc\xf1\xa6\x34\x82\x40\x02\x8c\xc8\x0f\xda\xf6\xcf\x07\xeb\x35\x5d\xa0\xe1\x58\x9e\xa6\x52\xf1\xf8\xd3\xfb\xf3\x8f\x9b\x4d\x21\x59\x5f\x18\x93\xe1\xf8\x4c\x2f\xe6\x60\xbd\x86\x48\x82\x5b\x6a\xc5\x40\x55\x62\x8c\x94\x52\x57\xe5\xeb\x23\x1e\x60\xe5\x40\xae\x78\x6e\x01\x56\xac\xe4\x73\xc0\x59\x80\x95\x13\xa0\x4f\x13\xfd\xff\x54\xc0\x82\xde\x68\x9c\x7c\x46\x83\x81\xdf\x47\x1a\xec\x31\x23\x70\xe3\x9c\x55\x22\x57\x7f\x5d\x22\x78\x02\x42\x51\x90\x66\x97\xb6\x60\xa3\x6d\x03\xf5\x95\x8b\xeb\x19\x04\xa9\xa0\xea\xf6\xb5\xe0\x69\x62\x6d\x2e\x42\x1e\x25\xcd\x65\x56\x38\x16\x42\x9b\x7e\x03\x2b\x3d\x2f\x39\xe5\x6c\x41\xc3\x54\x18\x4c\xa4\xd9\xaa\x4a\xef\x7a\x2d\x30\x0b\x01\x3d\x92\xf0\x05\x3d\xff\x7f\xa4\x37\x1a\x1d\xa3\xe1\x78\x7a\x42\x88\x00\x29\x0d\x69\x6a\x0a\x91\x65\x56\x0d\x58\x9a\x04\xe6\x45\xeb\xb5\xd6\xb5\xd9\xd4\x68\xe4\x42\x04\x59\xff\x0c\x3e\xf0\x25\x33\xe3\xd8\x7a\x5d\x3e\x99\xc6\x58\x68\xc6\x2b\x91\x42\xbf\x35\xdb\x5e\x74\x35\x69\x85\x15\x8c\xa7\x27\x51\x41\x89\x09\xa8\x25\x37\x48\x9e\xdd\x32\x1c\xd3\xc0\x6b\xea\xf2\x64\x3a\x67\xa0\x1c\x36\x3a\x37\x61\xbd\x7e\x54\x90\x87\x81\x9a\x99\xb9\x8d\x5d\xc9\xf7\xe6\xa0\xeb\x97\x85\xad\xc6\x21\x52\x19\x0e\x8f\x5a\xbb\xd0\x6f\xaf\xb4\xf9\xe4\x2a\xe3\x1a\xe3\x0a\x8d\xa5\x26\xda\x98\x29\x08\x05\x56\x50\x97\xaa\xb9\x38\x30\xbd\x94\xf1\xf4\x15\x17\x5f\xb1\x20\x94\x85\x39\xca\x0d\x2e\x55\x6e\xaf\x6e\x13\xb3\xe3\x13\x1a\x08\x2e\xf9\x42\x0d\xdf\x67\x04\x1e\xe5\x44\xd6\xaf\x14\x0b\x1c\x80\xcc\x50\x30\xbc\xcc\x1c\x60\x82\x19\x0e\x81\x9c\x51\x79\x2d\x37\x1b\x74\x50\x8f\x85\x3f\xe6\xcf\x2e\x97\x3c\x59\x61\x1a\xe1\x39\x8d\xa8\xba\x9d\x81\x1d\x39\xf7\x89\xb8\x33\xc5\x05\x0e\xa1\x6e\xac\xdf\xe5\xdd\x25\x92\x0d\xbf\x48\x22\xac\x16\x5c\xc4\xaf\x74\xec\x3e\xe3\x31\xa6\xec\xb4\x08\xd1\x4f\x5a\xce\x91\x0b\x5f\x26\x04\x2b\x68\x48\x3f\xf5\xfa\x07\xbf\xfd\xe6\xc5\x99\x35\x1e\x7a\x8e\x3c\xbd\x3f\x96\xdf\x23\xd4\xbd\x3b\xa7\x3c\x4e\x52\x05\x23\x6c\xa3\x52\xdf\x1c\x1d\x87\x51\xb6\x43\xf9\xda\x4f\x82\xa0\xe6\xf9\xdf\x95\xaf\x72\x0d\x7b\xe7\x2b\xd7\x0e\xda\x56\xc8\x3c\x75\xed\x9f\x9b\x6a\x4e\xf0\x86\x4b\x05\x64\x82\xa5\x02\x51\xe5\x1a\x3b\x77\x95\x4a\x8b\xf4\xe0\xb7\xc9\x9d\xa4\xf3\x88\x06\xa5\x4b\x82\x1c\xf9\x56\x2a\x8d\xcd\x1b\xa6\xb6\x94\x5e\x8d\x49\xaa\xcd\xec\x65\x3b\xd7\x9d\xe5\x32\x69\xe1\x96\xa5\x32\x90\x7e\xef\x73\xcc\xc9\x21\x26\xe4\xb0\xca\x65\xbd\xfe\x6e\xe0\xcb\xdc\xd6\xdf\xf9\x8e\x7c\x8b\x7a\x57\xbb\x45\xfd\xde\x67\x42\x57\x7f\x82\x39\x55\x80\xc8\x84\xcb\xdd\xe9\xca\xdb\x25\x2f\x70\x36\xe1\x63\xee\x5c\x56\xa5\x12\xcf\xe8\x37\x90\x13\x9c\xf8\x3d\x27\x97\x3f\x4d\xb4\x80\xdf\xbb\x1a\xda\xa6\x6a\x65\x57\xed\xaa\xaa\xed\xc0\x39\x08\x23\x7b\x7a\xe5\xbf\x25\xe1\x87\x6f\xb0\xcc\x43\xeb\x83\x77\x5b\x82\x15\x26\x54\x5e\xbf\xfb\xc7\x7d\x7f\xc8\x7d\x6b\xb3\x34\x94\x33\xc7\xcc\x19\x00\x69\x38\xcb\x9f\xef\xe7\x0f\xca\xee\x52\xed\x19\x56\xf8\x7f\x31\x28\x54\x2c\x7d\x80\x65\x96\xeb\x60\x6b\x63\xbd\x0d\x80\x7d\xca\x1a\xbd\x7a\x5d\x19\xad\x6b\x31\xb2\x59\x84\xde\x59\x61\xd8\x3c\xce\xfe\x10\x04\x8d\xc0\xf2\x8b\xcf\xf9\xab\x58\x87\xe3\xf7\x9c\xc0\xbe\xa7\x7d\x67\xb9\xd8\x15\x8b\x3b\x38\x3b\xf2\xf7\x8a\x25\x45\x0c\xd4\x31\x65\xcf\x23\x79\xdf\x0a\xf0\x37\x9f\x26\x72\x0a\xc2\x36\xf9\x81\x04\xc8\xbf\xe2\xa2\xb6\x94\x53\x07\x1d\x15\xc9\xfd\x32\xe3\x41\x01\xf9\x1d\xd9\xed\x2e\x89\xf4\x37\xc0\x60\x67\xd6\x2e\x82\x68\xab\x1b\xb6\xa5\x3e\x6c\xf5\x2e\x1a\xf5\xe1\x3d\x74\x09\xdd\x06\x75\xe5\xb5\x2e\x7b\x5a\x59\xd8\x51\xae\x7a\x0a\x87\x55\xaf\xa2\x9e\x4d\x04\x98\xa4\x3f\xe3\xa9\x08\xc0\xf4\x16\x1c\x65\x69\x08\x0c\x04\x56\x5c\x9c\x72\x02\x66\xcd\x83\x3d\xc1\xf9\x21\x50\x04\x48\x63\x8e\x16\x9a\xa5\x8b\x05\xbd\xc9\x0c\xab\x37\x3f\xcb\xa1\xc6\xf1\x82\x8b\x60\x09\x52\x19\x6b\x5b\xb3\xea\x83\x5a\x79\x9e\x84\x3f\xe2\xb0\xa1\x25\xe1\x3c\xd2\x02\x46\x43\x69\x6e\x3b\x27\xde\x7b\x0b\xfa\xfb\xf1\x33\x61\xf7\x52\x16\x35\xca\x98\x00\x53\x54\xdd\x56\x25\x05\xcd\x9f\xd8\x65\x45\x51\x5f\xc9\x5b\xa9\x20\x3e\x91\x92\x86\x0c\x88\xe3\x60\x66\x95\x27\x9d\x95\xb1\xcd\xc9\x8e\x66\x76\xb1\xcf\x63\xb2\x0f\xff\x7d\x77\xea\xe9\x66\x7f\xcd\x6c\x84\xbc\x25\x16\xe4\x2b\x16\x30\x15\x7c\x41\x23\x68\x9a\x94\x15\xec\x9d\xa5\x6e\x59\xae\xbb\x95\xe7\xc1\xa3\x43\x77\xbb\x2d\x6a\x37\xe1\x9b\xbd\xc3\x5d\x08\x75\x86\x2c\xbf\x7f\x8f\xb7\x1b\xcd\x9e\x76\xbd\x0b\x7d\xe5\x44\x85\xcb\x0e\x40\x30\x89\x29\xbb\x94\x20\x5c\xc7\x8b\x34\x7f\x6e\xbb\xa4\xa9\x7c\x0d\x31\xc4\xaf\xf1\xa3\x8c\xed\xaf\x41\xbd\x4d\xe7\x20\x18\x28\x90\x27\x21\x30\x95\x5d\xe1\xe8\xcc\x83\x86\x56\x27\xde\x8b\x28\x4b\x6f\xac\xdb\x16\xc7\x2d\x82\x47\xa8\xd4\xaf\x9f\x62\x29\xbf\x72\x41\x4e\x52\xb5\xd4\xfe\x58\xc5\x11\xd3\xdb\x6d\x5f\x4b\xc8\xa5\xfb\x4e\x22\xeb\x6b\xbc\x85\xdb\x36\xab\xdc\xdc\xca\xe7\x5d\xc3\xad\x5e\x84\x01\x32\xc1\x02\xc7\xa0\x40\xe8\x3c\x2c\x97\x17\xb3\x93\x69\xa1\xb5\xb9\x0b\xf5\x37\x63\xb5\x6c\x6e\x9e\x94\xcb\xb7\x70\x3b\xc5\x6a\xe9\xb8\x03\x41\xad\x7b\x10\x64\x71\xc7\x25\x61\xff\x32\xc1\xed\x0d\x96\xef\x34\xd4\x33\x08\x04\x28\xd9\xba\xf8\x69\x63\x97\x09\xb6\x82\xb4\x56\x92\x33\x34\xd7\xd5\x32\xba\x59\x41\xd4\xe9\x9d\x57\x2c\x6e\x8e\x1b\xea\x68\x80\x4d\xf5\xdb\xa4\x0a\x8d\x71\x08\x17\xb0\x00\x01\x2c\x68\x4e\xd5\x9e\xb3\x58\x80\x68\xda\xcb\xe5\x58\x4f\xfb\xa0\xc7\xda\xdb\x92\x11\x41\x2e\x3b\xe7\x4d\x8b\x71\xc7\x5c\x79\x9d\x76\xcc\x9a\xbd\xbd\x74\xc8\xaf\xdc\xe7\xda\x7c\x4e\x9e\x56\x1b\x60\x6e\x2c\x67\xe6\xe6\x58\xd0\x5e\xb9\x29\x48\xe0\x43\x52\x78\xc3\x2b\xc1\x63\xa3\xd4\xde\x97\xbe\x17\xe0\x60\x99\x5d\x56\x79\x17\x80\xc9\x7f\x04\x55\x96\xcc\xce\x03\x6a\xa6\xe6\x1e\x83\x49\xdf\x1f\x70\x49\xa8\xbc\x6e\xb1\xaa\xef\xad\x96\xc4\x15\x1c\x52\x41\xeb\xc6\x88\x82\x21\x87\xff\x9c\xa5\xff\x9e\x67\xe9\xfe\xf6\x56\x7d\x6f\x98\xdf\x8c\x9f\x33\x92\x70\xca\x94\x1c\xce\x23\x3e\xef\xfb\x19\xf1\xf6\x3d\xc2\xec\x0b\x16\x2a\x18\x3d\x5c\x2d\x49\x8b\xd5\xdb\xae\xa5\x8b\x96\x3e\xa0\xe1\x87\x99\xf6\x7c\x5d\x4e\xbd\x7e\x89\x8e\x5a\x0e\x49\xca\x41\xed\x20\x6b\x4b\x7c\xeb\xcd\x77\xdd\x80\x7d\x2e\xad\x8b\x3a\x73\x45\x85\x4a\x71\x34\x31\xf1\x04\x1c\xb7\x2a\x9d\xc5\x74\xc7\xf5\xca\x93\xa3\xe3\xdf\x07\xc7\x47\x83\xa3\xe3\x41\x22\x60\x45\xe1\xeb\x96\x0b\x95\x5d\x37\x2a\x8e\x2b\x94\x6d\xed\xba\xda\x8a\xcb\xc8\x16\xa6\x94\x38\x02\x48\xc7\xfa\xbf\x9f\x33\x9a\x17\xab\xb8\x38\x56\xd8\xad\xf3\x36\xec\xba\xe0\xe1\x82\x7e\x33\xf5\xce\x48\xf0\x08\xb2\xc3\x46\x0c\x4c\xc9\xdd\x3d\x77\x3d\xe1\x0c\x16\x94\x51\x3d\x7f\xdc\xfa\x20\x43\x00\x26\x20\x2e\x1a\x52\xcd\xe3\x9d\xa0\x2c\xa0\x09\x8e\xc6\x45\x99\xdd\x1d\x67\xef\x0e\x26\x8d\xd3\x93\xa3\xe3\xff\x1b\x1c\x3d\x1d\x3c\x3d\xf2\xfb\xc8\x7f\x95\x46\x91\xdf\x1b\x16\xd0\x0d\x6b\x76\x55\x77\x00\x75\x3e\x56\x48\xec\x4f\xe8\x11\xdc\x28\x60\xd2\x7c\xfc\x53\x62\xf0\x93\x79\x4f\x2f\x65\xd4\x70\x8a\xf3\xe2\x35\x16\xd8\xbf\x90\xf1\x0e\x3f\x7c\x36\x38\x7a\xe6\xf2\xc3\xc6\xc9\xbd\x38\x66\x99\x2f\xaf\x0e\x7b\xc3\x62\xb0\xbe\x0e\xf7\x8d\xe3\xd6\x0e\xce\x1d\x50\xc6\x46\xc1\xf1\xae\xad\xee\xa4\xdf\xf8\xcb\xbd\xbf\x96\x16\xae\x2a\xf8\xba\x3e\xff\xb2\xcb\xd6\xca\xbe\x06\xb9\x2c\x18\x4a\xea\x77\x10\xf0\x15\x17\xe6\x80\xd0\x9a\xf4\x06\x33\x12\x81\xa8\x71\xe4\x78\x78\x64\x49\xe1\x54\xf1\xcb\x24\x14\x98\xc0\x84\x32\x5e\x13\x6d\x7c\x78\xe6\x49\x50\x8a\xb2\xb0\xf5\x35\x9b\x97\x70\xa1\xc9\xfd\xec\xe8\xe9\xef\x4f\xab\x81\x7a\xf5\xab\xc1\x50\x10\x28\x20\xb3\x9a\x92\x32\x7b\xe5\x7f\x94\x2e\x5f\xcf\x73\x7f\xb5\x0f\x47\xf7\xbf\x50\x2a\xa6\xee\xea\x5f\xdd\xb7\xcb\xe9\xc8\x56\x45\xcc\xad\x41\xae\x66\xb3\x75\xf3\x77\xcf\x26\xee\xea\x60\xd4\xcd\xfa\xf9\x0f\x67\x7f\x2a\xcf\xdc\xef\xf1\x6a\x14\x48\xb8\x93\xcf\x73\x77\x05\xa3\x93\x6f\xa9\x80\xe1\xb9\x23\x8f\x56\xf8\x64\x7d\xa1\x59\x20\x68\xa2\x9a\xe3\xed\xb8\xf3\xc4\x8a\x3b\x7b\x87\x1d\x2b\xea\x6c\xac\x8a\xc6\x11\x51\x1a\xcd\xb3\x18\x33\xf2\x91\x9f\xdf\x40\x90\x2a\x6b\x53\xfc\x51\x2a\xc5\x68\x4e\xd9\x88\xf1\x65\x9a\x20\xf3\xe7\x1c\xcb\x25\x1a\x04\xe8\x0f\xaf\xfa\x39\xe2\x89\x1a\x61\x0d\xc6\x28\xe0\x4c\x61\xca\x40\xc8\x51\x22\xf8\x8a\x6a\x73\x87\x72\x89\xac\xe3\xa7\x02\x86\x99\xf9\x1e\xb6\xef\xdb\x23\x32\x9d\x4b\x03\x55\x5e\x9e\x35\xc7\xad\x8c\xdc\x1e\xae\x98\xea\x50\xcc\x20\x3f\x3d\x35\xc7\x98\x0c\xdd\x03\x39\x93\xf3\xbe\xea\x3e\x32\x17\x75\xfb\xdc\x13\x04\x4f\x15\x7c\xd4\x3f\xdc\xe3\xf9\xc9\xad\xd1\xc0\x76\xcb\x4a\x10\x2b\x1a\xc0\xb4\xa8\x0f\x4f\x23\x0a\x4c\xb9\x80\x73\x4b\x66\xed\xad\xb6\x74\x60\x46\xa7\xd9\x87\xd2\xa6\xdb\xd7\x94\x50\x58\x84\xa0\xce\xd9\x8a\x0a\x6e\x8a\x0a\x07\xac\x79\xf3\x9b\x47\x34\x70\x68\x58\x7c\x21\xac\x38\x9e\x16\x57\x36\x2d\x70\x19\xa8\x53\x46\x4d\xd6\x9e\x46\x69\x48\x99\xbc\xbc\x78\xe7\xb0\x97\xd1\x6d\xc3\x31\xbe\x99\x72\x22\x5d\xeb\xe4\x29\x99\x6a\x9e\x12\x10\x2f\x71\x70\xcd\x17\x8b\xfd\xa4\x2e\x40\x09\x0a\x7b\xaa\x3c\xbf\x49\x38\x73\x62\xe4\x92\x3e\xcb\xbb\xc4\xfb\x49\xff\x8b\x2a\x05\x62\x87\xec\x05\x56\x10\xd1\x98\xee\xb2\xa0\x94\xfb\xf7\x74\xb6\xaf\xe8\xcb\x34\xb8\x2e\x39\x84\xec\x86\x7d\x77\x96\x6c\xea\x4e\x25\x8c\x99\x54\x98\x05\x30\x01\x85\x09\x56\xd8\x08\xbd\x78\x81\x46\x2b\x2c\x46\x11\x0f\x8b\x08\x13\xa5\x52\x81\x18\x54\xe1\x25\xe2\x21\x7a\xf2\xe2\xf1\x31\x7a\xfc\x87\x87\x1e\xdb\x97\x31\xf6\x69\xe9\xe0\xbf\x01\x00\x00\xff\xff\x5b\xf7\x41\x5d\x65\x33\x00\x00")
+var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xdd\x6f\xdb\x38\x12\x7f\xde\xfc\x15\x82\xb1\xa8\x13\xc0\x1f\x49\xb3\xc5\x01\x0b\x5c\x81\x34\x49\x5b\x6f\x9b\xc6\x17\x27\xbd\x87\x6e\x1e\x18\x89\xb6\x89\x48\xa4\x96\xa4\xdc\xa4\x86\xff\xf7\x1b\x52\x94\x44\x4a\x94\xed\x6c\x93\x6e\xf6\xb0\x79\x68\x13\x71\x66\x34\x9c\xf9\xcd\x07\x87\x0a\x82\x20\x58\xee\x04\xfa\xa7\x83\x52\xf2\x19\x73\x41\x18\xed\xfc\x1a\x74\xbe\x2c\x10\x27\xe8\x26\xc6\x62\xb7\x5b\xad\x9c\xe0\x29\xca\x62\xd9\xdd\xbb\xee\xf4\x0a\xbe\x90\xa5\xf7\xc0\x51\xc8\xd1\x4f\x32\x2a\xb5\x10\x91\xdd\xec\x5a\x82\x96\xcb\xc1\x27\x94\xe0\xd5\xea\x58\x51\x74\xf7\x7a\x81\x6f\xf1\x7c\x3a\x15\x18\x56\xad\x97\x80\x50\x0a\x6b\x4a\x66\xcc\x58\xda\x31\x8f\x57\xa5\x12\x11\x4e\x31\x8d\xc4\xb9\xd2\xfd\xcb\xce\x72\x49\xa6\xc1\x60\x24\x8e\x33\x21\x59\xf2\xf9\xd3\xe9\xe5\x6a\x55\x50\xda\x1b\xa3\x62\x36\x3a\x51\x9b\x01\x0e\x1c\x0b\xec\xa7\x5a\x50\x2c\x2b\x32\x1a\x95\x54\xd7\xe5\xeb\x63\x16\x22\xe9\xb1\x5c\xf1\xdc\x31\x58\xb1\x93\x2f\x21\xa3\xb0\xec\x35\xd0\xe7\x33\xf5\xff\x98\xe3\x29\xb9\x53\x76\xea\x52\x12\xf6\xbb\xbd\x40\x19\x7b\x44\x23\x7c\xe7\xe5\x2a\x2d\x67\xbf\x2e\xe5\x2c\xc5\x5c\x12\x2c\xb4\x97\xd6\xd8\x46\xe9\x86\xe5\x57\xc6\x6f\x27\x38\xcc\x38\x91\xf7\xef\x38\xcb\x52\xc7\xb9\x40\x43\xa2\xfa\x36\x2b\x3b\x16\x44\xe0\x19\xd7\x56\x8a\x2f\x3d\x66\x74\x4a\x66\x19\xd7\x36\x11\xda\x55\x95\xdc\xe5\x92\x23\x3a\xc3\xc1\xcf\x02\xff\x11\xfc\xfa\xef\x40\x39\x3a\x38\x00\x55\xc7\x47\x51\xc4\xb1\x10\x1a\x34\x96\xc0\xc0\x51\xcb\x32\x2c\x49\x43\xfd\xa2\xe5\x52\xc9\x5a\xad\x2c\x18\xf9\x2c\x12\x38\x3f\xda\x3e\xa0\x81\x56\xe3\xc0\x79\x9d\x61\x26\x09\xe2\x0a\xf1\x92\x67\xb8\xd7\xe0\x76\x37\x5d\x31\x2d\x90\xc4\xb0\x95\xb8\x80\xc4\x19\x96\x73\xa6\x2d\x79\x72\x0f\x8a\x93\xb0\x53\x97\xd5\x81\xe8\x01\x7f\x78\x74\xf4\x3a\x01\x76\x5b\x80\x07\xb8\x26\x9a\xb7\xe6\x15\xe3\x9b\x9d\xb6\xbf\x1c\xdb\x2a\x3b\xc4\x32\xb7\xc3\xcf\x0d\x2f\xf4\x9a\x3b\xad\x3f\xb9\xce\xb1\x46\x99\x0c\x46\x42\x01\x6d\x44\x25\x9e\x81\xf3\xb1\x4d\x65\x85\x38\xa6\x6a\x2b\xa3\xf1\x5b\xc6\xbf\x22\x1e\x11\x3a\x33\x56\xae\x61\xa9\x0a\x7b\x79\x9f\x6a\x8f\x9f\x91\x90\x33\xc1\xa6\x72\xf0\x29\x07\xf0\xd0\x00\x59\xbd\x92\x4f\x51\x08\xae\xde\x31\xac\x45\x00\x9c\x21\x8a\x66\x38\x3a\x21\xe2\x56\xac\x56\x81\x5a\x5e\x7e\x5f\x3c\xfb\x42\xf2\x68\x81\x48\x8c\x6e\x48\x0c\xd1\x34\xc1\x6e\xe6\xdc\x26\xe3\x4e\x24\xe3\xa0\xa6\xad\x6c\xb7\x2d\xba\x4b\x4b\xd6\xe2\x22\x8d\x91\x9c\x32\x9e\xbc\x55\xb9\xfb\x84\x25\x88\xd0\xe3\x22\x45\xbf\x6c\x04\x87\x21\xbe\x4a\x23\xf0\x54\x8d\xfa\x10\xa8\x7f\xfa\xa9\x93\xe4\xda\x74\x02\x78\xa4\xfc\xe3\xc4\x7d\x10\xb4\x7b\xe7\x98\x25\x69\x26\xf1\x10\xb9\x56\xb1\x9d\xa3\xf2\x70\x90\x7b\xc8\xec\xfd\x28\x0c\xad\xc8\x7f\x50\xbd\x32\x12\xb6\xae\x57\x3e\x0f\xba\x5a\x08\x53\xba\xb6\xaf\x4d\x65\x10\xec\x32\x0e\x81\xf0\x9e\x09\x08\x80\x33\x04\xff\xf2\xe0\x3d\x12\xa7\x77\x44\x48\x80\x7a\xfe\x64\x9c\xdd\xc4\x24\x1c\x8d\xf7\xaa\x52\xe4\x96\xb6\xf2\x9d\x45\xf5\xe8\x36\xb1\x9f\x1a\x21\x26\x62\xb1\x18\x76\x9d\x4a\x9b\x38\xaf\x32\x54\x6a\xb3\xba\xe6\xd6\x8b\x9b\x1b\x7b\x8f\x56\xea\x84\x63\xd6\xbc\xd2\x61\xc0\xf6\x97\x84\x45\xbb\x28\x8a\x76\xab\x52\xb7\xd7\xdb\xec\x97\xb2\xf4\xf5\x36\xbe\xc3\x78\x70\xef\x7a\x33\x29\xa8\x13\x91\xc5\x5f\xa0\x4e\x95\x3f\x72\xe2\xd2\x3b\x6d\x65\xbd\xc4\x05\xca\x19\x2e\x4d\xec\x39\x8d\x4c\x32\x21\xdf\x30\x24\xbe\x14\xf6\xe5\xef\x3a\x14\x01\x78\x70\xe0\xaa\xaa\x84\x5d\x37\x9b\xae\x66\x7c\x1b\x23\x0c\x5d\xf6\x2a\xbc\xcb\x78\x18\x00\xf2\x4d\xe6\x7d\xf6\x51\x0d\x79\x10\x45\xa0\xeb\xc7\x7f\xa2\xfb\x29\xa2\xdb\xe2\x52\x96\x9e\x78\x38\x27\x18\x47\xb5\x58\xfa\xeb\xd3\xc0\xb3\xd2\xbb\x14\x7b\x02\xaa\xfc\x3f\xe6\x8c\x0a\xa5\xcf\xb0\x49\xf3\x1d\x8b\x5d\x5b\xaf\x33\xc0\x36\x4d\x91\xda\xbd\xea\xab\x96\x56\x0a\xad\xb7\xb0\x8f\xd6\x56\xd6\x0f\xc3\x7f\xca\x04\xb5\xc4\xf2\x83\xa7\x04\x8b\x44\x65\xeb\x4f\x2c\xc2\xdb\xce\x0a\xbc\xcd\x66\x5b\x2e\x6e\xc1\x2c\x64\xe2\x87\xe4\xc0\xe5\xf2\x1d\x96\x47\x33\x4c\xa5\xfb\x72\x9d\x5f\x82\x01\x1c\xb2\x9e\x41\xc2\x7b\x0e\x4a\xae\xe9\x86\x76\x5a\x1a\x8a\xa7\xf5\xdc\x93\x1a\xe6\x01\xd5\xe4\x31\x1d\xfd\x37\xdc\xd3\xc6\xaa\x57\x24\xa1\xc6\x2c\x6a\x4d\x7f\xd5\x98\x1c\xd4\xfa\xab\x27\x98\xd1\xf9\x15\x6a\xab\x0b\x6d\xfa\x34\xaa\x98\xa7\xdd\xeb\x48\x34\xab\x26\x05\x76\x36\xe6\x58\x17\xcd\x09\xcb\x78\x88\xf5\xc9\xde\xd3\xd6\x01\x32\x30\x47\xe0\xa0\x63\xc8\xac\x7a\xcf\xfd\x2d\x8d\xf3\xa7\x8c\x02\x9d\xab\x56\x47\x11\x4d\xb2\x29\x88\xca\x15\xb3\x47\x8f\xe5\x52\xad\x7b\x67\x3c\x9c\x63\x21\xb5\xb6\x9a\xab\xc2\xf6\xb9\xb5\xa4\x44\x9b\x12\x76\x89\x66\x0a\xe2\xb6\x90\x94\xb1\x58\x51\x18\x01\x46\xdb\x66\x49\x79\xf2\xf9\xef\xc3\xcd\xa7\xb3\xe2\x95\x28\x4a\xfc\x28\x82\x8d\x03\x30\xaa\x8a\x4c\xcc\x13\xb7\x2a\x17\xed\x89\xb8\x87\x53\x44\x72\x24\x04\x99\x51\x1c\x79\x8e\x3d\x4e\x75\x6f\x6d\x2c\x5d\x48\xb6\x4c\x92\x0b\x37\x8f\xa2\x6d\xe0\xdf\xf5\x57\x86\x76\xf0\x5b\x6a\xc3\x3b\xe7\x88\x47\x5f\x11\x07\xdb\xb2\x29\x89\x71\x5d\xa5\xbc\xdf\x6d\xed\x14\xcb\x6e\xd7\x2f\xdc\xe4\x8e\x16\xd9\xcd\x99\xa4\x3b\x01\xaf\x0f\xee\x36\x59\xa8\x35\x63\x75\x7b\x4f\x78\xb5\x50\x1f\x28\xdb\x23\xe0\x6b\xaf\x55\x98\x68\x31\x08\x8a\x12\x42\x01\xa3\xdc\xd7\x9d\x67\xe6\xb9\x1b\xd7\xba\x71\xd4\xc0\xe0\x3f\x26\x8e\xb4\x5b\x54\xea\xf8\x90\xdd\xc0\x3b\xb1\xc4\x42\x27\x91\xfc\xfe\x44\x15\x1e\x95\x34\x6c\xfd\x62\x42\xb3\x3b\xe7\xaa\xc3\x33\xc2\xef\x44\x44\xa8\xd7\x8f\x91\x10\xe0\xbc\xe8\x28\x93\x73\x15\x8f\x55\x1e\xd1\x83\xd5\xe6\x9d\x80\x98\xfb\x2f\x04\xf2\xb1\xc0\x07\x7c\xdf\x44\x95\x1f\x5b\x86\xef\x16\xdf\xab\x4d\x68\x43\xa6\x88\x83\x1d\xc0\xb4\xaa\x0c\x8b\xf9\xc5\xe4\x68\x5c\x48\xad\x7b\xc1\x7e\x33\x92\xf3\xba\xf3\x80\x1b\x98\xc6\xb0\xe2\xb9\x80\x50\x3f\xf5\x2b\x12\x1b\x3b\x3e\x0a\xf7\x2f\x9d\xdc\xa0\xe3\xfb\xa8\x4c\x3d\xc1\x50\xbc\xa4\x68\xdc\xba\x34\x6d\x97\x13\x36\x92\xb4\x12\x62\x10\x6a\x64\x35\x94\xae\x37\x10\x36\xbc\x4d\xc3\xe2\xc7\xb8\x86\x8e\x32\xb0\x6e\x4e\xeb\x50\x21\x09\x30\x5e\xe0\x29\xe6\x98\x86\x75\x56\x15\x39\x53\x58\xaa\xeb\xcb\xc4\x48\xb1\x9d\xab\xb5\xa6\x5b\x72\x20\x88\x79\x2b\xdf\xb8\x58\xf7\xf0\x8a\xdb\xac\x85\x6b\xf2\xe1\xca\x43\xbf\xf0\x1f\x0b\x0d\x8f\xa9\xab\x35\x63\xae\x9c\x60\x66\xba\x6b\x6f\xee\x5c\xf7\x23\xf8\x3c\x2d\xa2\xe1\x2d\x67\x89\x16\xea\xfa\xa5\xd7\x09\x51\x38\xcf\x6f\x8a\x3a\x17\x18\x45\xff\xe5\x44\x3a\x34\x1b\xcf\x77\xb9\x98\x27\x4c\x26\xbd\x6e\x9f\x09\x35\x40\x6c\xa0\xaa\xd7\x59\xcc\x23\x5f\x72\xc8\x38\xb1\x95\xe1\x05\x42\x76\xff\x39\x8a\x3e\xcf\xa3\xa8\x4d\xe2\x19\x54\xef\x0d\xcc\xb5\xf1\x29\x8d\x52\x46\xc0\x3f\x83\x9b\x98\xdd\xf4\xba\x39\x30\xb6\x3d\x61\x6c\x0b\xb8\xa0\x40\xdc\x00\xf0\xd5\x40\xdd\xba\x3b\xdb\x62\xa0\x8d\x83\xc1\xf9\x44\x45\xa6\x6a\x77\xde\xbd\x09\xf6\x1b\x01\x13\x95\x8b\x0a\xc0\x4b\x87\x7c\xed\xb5\xb0\xad\xc0\x36\x37\xba\x45\x1f\xb8\x20\x5c\x66\x28\x3e\xd3\xf1\x8e\x3d\x77\x0a\xad\xcd\x6e\xcb\xe5\xc2\xcb\xfd\x83\x5f\xfa\x07\xfb\xfd\xfd\x83\x7e\xca\xf1\x82\xe0\xaf\x6b\xae\x13\x36\xdd\x27\x78\x2e\x10\xd6\x4d\xa3\xac\x1d\x97\x99\x67\x96\x91\xc8\x13\xe0\x2d\xfb\x7f\x38\x66\x14\x2e\x16\x49\xd1\xf6\xbb\x93\xe1\xa6\xd9\x55\x43\xc2\x38\xf9\xa6\xfb\x91\x21\x67\x31\xce\x0f\x03\x09\x56\xc3\xd9\x8d\x23\x65\xc5\x70\x02\x3a\x50\xa2\xf8\x47\x8d\xaf\x15\x20\xbd\x47\x98\x5f\xd4\xa8\x6a\x67\x38\x08\x1a\x1a\x92\x14\xc5\xa3\xa2\x0d\x6e\xcf\x83\x8f\x67\x26\x65\x27\xc0\xc6\xbf\xfa\xfb\x87\xfd\xc3\x7d\xe0\xef\xbe\xcd\xe2\xb8\xbb\x37\x28\x4c\x37\xb0\xf4\xaa\x46\xdc\x36\x1e\x2b\x4b\x6c\x0f\xe8\x21\xbe\x93\x98\x0a\xfd\x65\x4c\x69\x83\xef\xac\x4b\x6a\x2b\xc3\x5a\x50\x9c\x16\xaf\x71\x8c\xfd\x03\x11\xef\x89\xc3\x57\xfd\xfd\x57\xbe\x38\xac\x9d\xac\x8b\x63\x90\xfe\x2c\x69\x77\x6f\x50\x2c\xda\xfb\xf0\x5f\xa8\xad\x1d\xb0\x3c\x02\x64\x5c\x2b\x78\xde\xb5\x36\x9c\xd4\x1b\x7f\x78\xf4\x5b\x65\xe1\xba\x32\x5f\xdb\xb7\x51\x6e\x5b\x59\xe9\x57\x03\x97\x63\x86\x12\xfa\x2d\x00\x7c\xcb\xb8\x6e\xe0\x1b\x4c\xef\x11\x8d\x62\xcc\x2d\x8c\x1c\x0c\xf6\x1d\x2a\x94\x49\x76\x95\xce\x38\x24\x91\x33\x42\x99\x45\x5a\xfb\x2a\x0b\x9a\x7e\xa9\x2e\x60\x1b\x9f\x7a\x75\x52\xc6\x15\xb8\x5f\xed\x1f\xfe\x72\x58\x2d\xd8\xdd\xa9\x32\x86\xc4\xa1\xc4\xd1\xc4\x12\x52\x56\x2f\xf3\x4b\x19\xf2\x76\x9d\xfb\xbb\x7d\x55\xb9\xfd\x7d\x49\xc1\xba\x69\xbe\xf4\xd4\x21\xa7\x32\x5b\x95\x31\xd7\x26\x39\x4b\x67\xe7\x62\xeb\x89\x55\xdc\x34\x61\xb0\xd5\x72\x3a\x24\xdd\xe7\x9a\x2f\x06\x38\x5b\x10\xb5\x0f\x40\xdf\x89\xf6\x03\x94\x3f\x08\xce\xef\xfd\x06\xf5\xbb\xaa\xd2\xd3\x1e\x96\x86\xa1\xc0\x8f\xf2\xa5\x6b\x15\xc6\xfe\xd4\x75\xf4\x2d\xe3\x78\x70\xea\xa9\xba\x95\x7d\xf2\x29\xcf\x24\xe4\x24\x95\xf5\xf5\x66\x96\x7a\xe9\x64\xa9\xad\x93\x94\x93\xa3\x56\x4e\xff\xe3\xc9\x3f\xb5\x51\x58\x02\x5a\x5c\xb2\xd3\x3b\x1c\x82\xf7\x6c\xa7\x74\x87\x99\xe0\xc3\x1b\x42\x87\x94\xcd\xb3\x34\xd0\xbf\xde\x20\x31\x0f\xfa\x61\xf0\x7b\xa7\xfa\x73\xc8\x52\x39\x44\xca\x18\x43\x60\x95\x08\xdc\xce\xc5\x30\x2d\x90\x37\x00\x12\xe7\x30\x09\xf6\x42\x54\x7f\x5a\xda\xeb\xba\x2b\x90\x98\x84\x36\x95\x69\xe6\xea\xeb\x4e\xfd\x6e\x2e\x57\x48\xf5\x08\xa6\xd8\x9c\xb5\xea\x6b\x54\xcc\xfc\x0b\x06\xc9\x66\x4a\xba\x0d\xcd\x85\xad\x9f\x9f\x01\x56\x24\xbe\x54\x7f\xf8\xd7\xcd\x39\xaf\x36\x8e\xf6\xd3\x0a\xcc\x17\x24\x84\x18\x31\xdd\xe4\x71\x4c\x20\x7b\xf9\x0c\xe7\xa7\xcc\x87\x55\x4d\xea\x50\xaf\x8e\xf3\x6f\x8e\xf5\xec\xae\x4e\x21\x11\x9f\x61\x79\x4a\x61\xfb\x4c\xb7\x20\x1e\xb3\x9a\x51\x36\x8b\x49\xe8\x91\x30\xfd\x23\xa2\xc5\x61\xb6\xb8\x7f\x69\x18\x17\x64\x1c\x53\xa2\x6b\xfc\x38\xce\x66\x84\x8a\xab\x8b\x8f\x1e\x7d\x29\x59\xb7\x9c\xa0\xbb\x31\x8b\x84\x6f\x9f\x2c\x8b\x74\x86\x84\xa3\xc4\x1b\x14\xde\xb2\xe9\x74\x3b\xaa\x0b\x2c\x39\xc1\x5b\x8a\x3c\xbd\x4b\x19\xf5\xda\xc8\x47\x7d\x62\x66\xbe\xdb\x51\xff\x46\xa4\x54\x93\xb8\xb5\xb4\x17\xe0\xc6\x98\x24\x64\x93\x06\x25\xdd\x7f\xc6\x93\x6d\x49\xdf\x64\xe1\x6d\x89\x21\xbb\xc0\x65\x8d\xd2\x6e\xd5\xd4\xba\x6c\x20\x1e\x51\x21\x11\x1c\xcd\xce\xb0\x44\xea\xb6\x57\x13\xbd\x7e\x1d\x0c\x81\x6c\x18\xb3\x59\x91\x61\xe2\x4c\xd5\xb5\x7e\x95\x5e\x60\x2d\x78\xf9\xfa\xc5\x41\xf0\xe2\xf7\x4e\xf0\xc2\xbd\x5a\x71\xcf\x56\x3b\xff\x03\x6b\xc6\x36\x3d\xb0\x32\x00\x00")
 
 func kubernetesagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -697,7 +697,7 @@ func kubernetesagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\xbf\x6e\xdb\x30\x10\xc6\xf7\x3e\x05\xa1\x85\x22\x40\x27\x6e\x81\x0e\xed\x66\xa4\x1d\x3c\x38\x35\xaa\xc2\x1d\x8a\x0e\xb4\x74\x72\x09\x48\x64\xc0\xa3\x1c\xb7\x82\xde\xbd\xa0\x14\x25\x64\x44\x09\x69\x91\xc5\x96\x70\xdf\xfd\xee\xcf\x77\x6a\x5b\x59\x92\xab\x2d\x66\x56\x1b\x71\x82\x4d\x9e\xeb\x46\xd9\xae\x7b\x43\x08\x21\x49\xdb\x5e\xdd\x8a\x1a\xba\x2e\x0c\x7f\x29\x4b\x04\x9b\x7c\x24\xc9\x8f\xba\xa9\xd2\xb3\x30\x52\x1c\x2b\xc0\x94\xd6\xe2\x12\x4a\x71\x0f\x66\x73\x02\x65\x29\xe3\x9e\xee\x11\xbc\x55\x05\x5c\x28\x63\x3f\x13\xbe\x58\x12\x6f\xdc\x6f\x5f\x52\x14\x45\x5a\xc8\x73\x1a\xc3\xf5\x2a\xca\x38\x09\x7b\x3a\xec\x5c\x1f\x21\x91\x32\xc6\x49\xad\x8b\xd4\xf1\xdc\xff\x2b\xf0\xde\x31\x4e\x5e\x11\xf7\x96\x0d\x8b\x69\x5b\x50\xc5\xc4\x93\xa7\x8d\xdc\x09\x23\x6a\xb0\x60\x22\xb5\xa6\x8b\xf5\xdc\x8b\x26\x0e\xf1\x58\xe6\xe6\x2c\x64\x25\x8e\xb2\x92\xf6\x77\xf6\x80\xc8\xb5\xca\x85\xf5\xd2\x57\x22\x54\xad\x68\x30\xae\x12\x35\x64\x4d\x59\xca\xd1\xf5\xf1\x02\xbf\x4b\x55\xe8\x7b\x1c\xc7\xbc\x97\xea\x2b\xa0\x6e\x4c\x0e\x8e\xbb\x37\x50\xca\x4b\x42\x5c\x49\x6c\x8e\x68\x8d\x54\xa7\x74\x86\xcb\xc9\x9a\x93\xf7\x91\x01\x0e\x3b\x8f\xe5\x75\xef\x71\xa2\x75\x9f\x59\xa6\x4d\xfe\x0b\xd0\x1a\x61\xb5\x71\x22\xfa\x60\xfb\x87\xf5\x7a\xf1\xc8\x47\x33\x2b\x84\x89\x9b\x2f\x68\x2d\x56\x96\xae\x9e\x16\x3f\xbf\x68\xa7\xeb\x0d\x25\x33\xb7\x74\xd8\x65\xf2\x0f\xcc\xdf\xc4\x10\xa7\x81\x61\x37\x0d\x5a\x5d\x1f\x6e\x3f\x7f\x9b\xe2\x14\xd8\xac\x39\x2a\xb0\xdb\x4f\x0b\x50\x4f\x15\x3b\xb7\x21\xe6\x9e\xff\x9f\xe1\xe2\x7b\x61\x2c\xf6\x08\xbc\xab\xa4\x4d\x5f\x00\xe2\xf4\x1a\xfb\x17\xbc\xa6\x8b\xae\x3d\x1f\xd4\x33\xe0\xfc\x6f\xe3\x79\x99\xf8\x18\xa1\xc1\xf7\xff\x37\x00\x00\xff\xff\x5d\x57\x93\x38\xad\x05\x00\x00")
+var _kubernetesagentvarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\xbb\x4e\xc3\x30\x14\xdd\xf9\x0a\x2b\x8b\x53\x29\x7d\x80\xc4\x00\x5b\x55\x18\x3a\xb4\x54\x04\x95\x01\x31\xb8\xc9\x4d\xb1\x94\x38\x95\xed\xa4\x85\xa8\xff\x8e\x9d\xb4\xa9\x53\xdc\x12\x50\x33\xe4\x75\xcf\x3d\xe7\xdc\x87\x8b\x82\x46\xa8\x37\x16\xbe\x4c\x39\x59\xc2\x30\x08\xd2\x8c\xc9\xed\xf6\x0a\xa9\xcb\x29\x8a\xde\x94\x24\xb0\xdd\x36\xc3\x4f\x51\x24\x40\x3a\xf7\xc8\x79\x4b\xb2\xd8\xcd\x09\xa7\x64\x11\x83\x70\x71\x42\x36\x4d\xa8\x98\x01\x1f\x2e\x81\x49\xdc\xf1\x0c\x5c\x4d\x3c\x66\x21\x6c\x70\xa7\xf3\xee\x78\x4a\xb2\x28\xdd\xf8\x2b\x0e\x24\x3c\xe2\xf9\xc5\x92\x18\xe9\x7b\x65\x89\x32\xd7\x26\x55\x22\x94\x0d\xd4\xd2\x6f\xed\x09\x62\x01\xed\xe5\x49\x18\xba\x21\xcd\xff\x62\x61\x3e\xd1\xb2\x4d\x46\xa5\xef\xa1\x24\x0d\x5d\xcd\xa7\x9f\x17\xe0\xbb\x51\xc8\x0b\xd2\x5d\x77\x8c\x1e\xb1\x50\xb5\x68\xff\x6c\xb6\xea\xd0\x9b\x15\xe1\xea\x8f\x04\x6e\x51\xad\x98\xcc\x3c\x63\xcd\xac\x89\x55\xdc\x96\x39\xcc\x09\x8d\xc9\x82\xc6\x54\x7e\xfa\x3b\x8a\x20\x65\x01\x91\x46\x7a\x97\x34\x51\x5d\xdc\x28\x9c\x29\x90\x9f\x45\x11\xdd\xaf\x67\xb1\x3b\x2a\xaf\x94\x85\xe9\xba\x5e\xc8\x35\x65\xcf\x20\xd2\x8c\x07\xa0\x79\x67\x1c\x54\x8a\x83\xb4\xa4\xc8\x16\x42\x72\xca\x96\xee\x09\x5e\x0f\x0d\x3c\x74\x6b\x29\x60\x3e\x31\xb8\x0c\xf7\x06\x8f\x55\xf7\x68\x78\x29\x0f\x3e\x40\x59\x20\x6a\x76\x1a\x84\x77\x0b\x70\x37\x18\x9c\x3d\x8d\xbb\x7a\xad\x8b\xdf\xc2\x9a\x4d\x16\x77\x0f\x8d\x3f\xdd\x68\x8d\x2b\x07\x8a\x4e\xec\xd2\x7c\xe2\xd3\x2f\x38\xbd\x13\x55\x1c\x37\x06\x36\xca\x84\x4c\x93\xf9\xf4\xf1\xe5\x27\x1d\x03\xe9\x67\x0b\x75\x1f\x3f\x9c\x21\x35\x50\xb6\x75\xab\x62\xfa\xfd\xff\x1c\x3a\x3e\x23\x5c\x8a\x92\x42\xac\xd4\x52\xba\x2d\x88\x3c\xdc\x17\xe5\x87\xe8\xe3\xb3\x53\x3b\x2e\xd4\x18\x40\xfe\xb7\xf2\x8c\x4c\x51\x47\x6a\xf1\x72\x66\xdf\xab\xf2\x65\x98\x56\x06\x00\x00")
 
 func kubernetesagentvarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1177,7 +1177,7 @@ func kubernetesmastergenerateproxycertscriptSh() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x1c\x6b\x6f\xdb\x38\xf2\xf3\xf6\x57\x10\xc2\xe1\xd4\x2c\xfc\x48\x9c\x14\xb7\x17\xe0\x16\x48\xf3\xd8\xfa\x9a\xb4\xbe\x38\xed\x7e\xe8\x06\x0b\x5a\x1a\xdb\x44\x64\x52\x4b\x52\x4e\xb2\x86\xff\xfb\x81\x7a\x8b\xa4\x64\x39\x71\xda\xee\x5d\x3e\xb4\xb1\x39\x24\x87\xf3\x9e\xe1\x30\xab\x15\x99\xa2\xde\x15\x16\x12\xf8\x88\xb3\x29\x09\xa0\x37\x14\x57\x98\xe2\x19\xf8\x67\x44\xdc\x89\xf5\x1a\xbd\x42\x08\xa1\x55\xfc\x2f\x42\x0e\x0e\xc9\x67\xe0\x82\x30\xea\x1c\x23\xe7\xcb\x12\x73\x82\x27\x01\x88\xd7\x6e\x31\x32\x96\x8c\xe3\x19\x94\xd7\x71\xf7\x6e\x9d\x4e\xb6\x46\xc0\x3c\x2c\x2d\x2b\x64\xdf\x57\x80\x29\x5e\x80\x0e\xb8\x88\x31\x3e\x59\x62\x12\xe0\x09\x09\x88\x7c\x1c\x83\xac\xcc\x0a\x39\x0b\x81\x4b\x02\xc2\x39\x4e\xbf\x2b\x0e\x91\xc1\x04\x58\x4e\x19\x5f\x5c\xe0\x28\x90\x67\x6c\x81\x09\x3d\x65\x11\x95\x6a\xb7\x41\xbe\x94\x06\xfc\x29\xf4\xb1\x04\x0d\xfa\xd0\xe9\xbc\xfa\xe1\x87\x1c\x76\x91\x1c\xdc\x41\xc7\xc8\x91\x3c\x02\x27\x5f\x6a\x9d\x23\x28\x1f\xc3\xf8\x58\x57\xc4\xe3\x4c\xb0\xa9\xec\x9d\xb2\x45\x18\x49\xe8\xe3\xea\xb1\x44\x32\x7b\xdd\x79\xb5\x5a\x41\x20\x00\xd9\x58\x96\x52\xfc\xc4\xf3\x14\x4a\xeb\xf5\xf6\x3c\x3b\x83\xa9\x22\xc3\xb7\xe4\x13\x5a\x3d\x8b\x3c\x4f\x15\xd3\x0a\x3e\x3e\x84\x40\x7d\xf1\x51\x4d\xfb\x92\xb3\xcd\xf9\xe2\x31\xea\x61\xf9\xda\x2d\xf0\xf9\x00\xf2\x9e\xf1\xbb\x7e\x18\x4d\x02\xe2\x0d\x47\x27\xbe\xcf\x41\x08\x10\x7d\xb7\x83\x0c\x1a\x8c\xaa\x50\x1f\xf0\x02\xdc\xbd\xbd\xdb\x4c\x32\x6e\x77\x4d\xf3\xaa\x40\x24\xdb\xd5\x92\xbd\x38\x27\x4e\xe0\x6f\x52\xea\x97\xd7\x5d\x2e\xc6\xe4\x4f\x10\x57\x38\x74\xf7\xcc\xfd\x3e\x5f\xa9\x51\x77\xef\xb6\x27\x2a\x3b\xab\x95\xf2\x53\x36\xb1\x37\x45\xb8\x5f\x9d\x5e\x11\x7e\xea\xaf\xd7\xaf\x62\x93\x45\x99\x34\x75\xe0\x34\x12\x92\x2d\x3e\x7f\x38\xbf\xd9\x95\xfc\x6f\x2f\x0c\x34\xf9\x7f\x0c\x5e\xc4\x89\x7c\xfc\x85\xb3\x28\xd4\x05\x82\x8a\x59\xc1\xfe\xfc\x38\x43\xa1\x30\x1f\x52\x09\x33\x8e\x25\xf8\xe9\x19\xd4\x4f\xa7\xd5\xd6\x9c\x45\x12\x6e\xe2\x3d\xb4\x0d\x8b\x91\xf2\xbe\x09\x39\x77\x2e\x7e\x4b\xc2\x65\x84\x83\x14\xab\xf6\x82\x97\xe8\xc5\x38\xc4\x1e\x54\x46\x8a\xb1\x11\x87\x29\x79\x88\x27\x7e\xa9\xda\xe6\xca\xfe\x14\xe4\x29\xf1\xb9\x5b\x28\x57\x7c\x42\xd3\x04\x23\xe4\x88\x68\x42\x95\x09\xa9\xae\xa8\xb9\x09\xdb\x29\x93\x89\xfa\xe9\x9a\xcf\x68\x3b\x8d\x7d\x5d\x73\x4d\x85\x86\x45\xb4\x2c\xeb\x23\xe4\x10\x5f\x5f\x96\x8a\xd9\xf0\x4c\xa3\x48\x4c\x8b\x56\xf2\x97\xfc\x18\x08\x15\x62\xd5\x16\x8d\x62\x46\x2d\x36\x65\xa9\xcc\xbe\xb5\xfd\x7e\xdb\xc2\xa4\x64\x9a\x51\x15\x49\xd3\xa4\xa0\xef\xc2\x57\xe6\x66\xa1\x85\xb6\x88\x54\x08\xae\xa3\x20\xd5\x87\x24\x92\x7b\x87\xc5\xaf\x84\xfa\xec\x5e\x54\x88\x58\x23\xd0\x38\x08\xd8\xfd\xef\xdc\x0f\x9d\x0e\xda\x4a\x82\x3d\x0f\x84\x1a\x71\x4e\xd4\x0a\xfa\xec\xd8\x70\x0a\x8f\x93\x30\xa3\x47\x0c\x86\xae\xcf\x46\x48\x72\x3c\x9d\x12\x0f\x49\x86\x12\xbf\x61\x9f\x2c\x09\x8d\x89\x76\xa2\xeb\xca\x8f\xcd\xf0\x23\xc6\xe5\x35\xa6\xb3\xf8\x78\x87\x87\x3f\xfd\xb3\xab\xfe\xb1\xcd\x21\x1c\xbc\x0c\xbd\x21\x9d\xb0\x88\xfa\x16\xb0\x90\x13\xa6\xe8\xec\x1c\xa3\x83\xfd\x81\x6d\x9c\x49\xe6\xb1\x40\xad\x72\xe3\x19\x74\x54\x9c\x62\x11\xf7\xa0\xd5\x39\x12\xd0\xca\x11\x7e\x74\xea\x55\x21\x97\xdf\xf4\x8b\xb6\xfc\x16\x62\xbe\xa5\xc1\xd2\xd9\xdd\x8a\xdb\xe3\xf1\x3b\x1b\xb7\xb7\x64\x76\x5b\x5e\x0f\x06\xdd\x81\x1e\xb2\xd7\xb2\xb9\x91\xcb\x07\x96\x61\x8d\xc9\xed\x79\xfc\x6c\x16\x17\x1f\x1a\x79\x7a\x17\x4d\xe0\x77\x19\x88\xaf\xc1\x58\xb5\x57\x17\x87\x44\x00\x5f\x02\x47\xaf\x65\x20\xf6\xbe\x22\xa7\x8f\x8e\x0e\xbb\x47\x47\x87\x3b\xe1\xf5\xfe\x77\xc4\xeb\x27\x79\x36\x6b\xb8\x59\xf2\x6f\xcd\xbe\xfd\xdb\xfb\x3c\x3d\x32\x2d\x81\xd7\x1f\xba\x14\xe8\xbe\x8c\x2b\xff\x5f\xc9\x01\x2f\x27\xad\x03\x8a\x09\xf6\xee\x80\xfa\x99\x5c\x33\x16\x3c\x21\x28\xce\x76\x7d\x9b\x2c\xa6\x56\xc9\x10\xb0\x4b\x7a\x29\x12\x9f\x72\x46\x25\x50\x7f\x38\x3a\x65\x74\x4a\x66\x11\x8f\x4f\xfa\x0c\x2c\xb2\x95\x9e\x14\xa4\x6b\x0c\x6d\x0c\x70\x39\x24\xaa\x3e\xf4\x5b\x89\x86\xdb\xd9\x56\x30\x4c\xca\xe9\x9f\xec\x34\x0d\x18\xf6\xdf\xe2\x00\x53\x8f\xd0\x59\x11\x2a\x6e\x22\xe6\xe5\x5b\x05\xfb\xee\xe6\x66\x34\xde\x8e\x68\x35\x3c\x6c\x9b\x1d\xe8\x8c\xb3\xe7\x08\x86\x9d\x35\x45\xb7\x71\xc3\x54\x89\x6d\xfb\x9e\xb9\x7b\x1d\xe4\xf6\x2d\xba\x60\x55\x67\x8b\xa0\xb7\xc1\xb7\xec\x62\xa4\xcd\xc5\x64\x64\x54\xae\xc3\x39\x46\x47\x47\x87\x75\x67\x6e\x80\x00\xaa\x70\xbd\x08\x18\x96\x84\xce\x86\x23\xe7\x18\x4d\x71\x20\xc0\x00\x24\x7e\x00\x37\x64\x01\x2c\x92\x43\x7a\x45\x68\x24\x63\xe6\xbe\x31\x00\x95\x34\x9d\x11\x21\x39\x99\x44\x99\x71\x4a\xad\xa7\xcd\xcd\xb2\x49\x73\x5a\xb8\x81\x0f\x6e\x3f\x5e\x42\xf4\xa5\x17\xc6\xa2\x38\x52\x1f\xad\x49\x63\xdd\x27\xbb\x52\x24\xcb\xb6\x33\x2b\x95\xbd\xb7\x34\x20\x9b\xb8\x1c\xd6\xf3\x8e\x50\x09\x7c\x89\x83\x21\x1d\x83\xc7\xa8\x1f\x87\x68\x6f\x2c\x15\x81\x68\x31\x01\xfe\x71\x3a\xca\x8e\xe4\x0c\x76\x1d\x60\x14\x26\x04\xb8\x16\x58\xcc\x8c\x12\x5c\x5c\x0e\x47\x07\x2f\xe3\x86\xed\x57\x15\x46\xcd\x0f\xe9\xf5\xa0\xa2\xf4\x91\x94\xcf\xeb\xe0\x96\x14\x64\x01\xf8\x32\xb5\xb1\x44\xc2\x55\x1c\xc6\x29\x0e\xfe\x9f\xdd\x73\x41\x83\xe7\xb9\x69\x4e\x96\x58\x42\xd9\x4f\x57\x36\x53\xb9\x0a\xa7\x20\x41\x9c\x8c\x86\xe3\x38\x61\x19\x8e\xac\xd5\xb5\x62\xa5\x20\x63\xe7\x15\xc8\x39\x8b\x8d\xd5\x58\x62\x49\x3c\x4b\x88\x1f\x57\xeb\xda\xfa\x37\x25\x61\xe3\x78\x46\x4d\xf5\xab\xee\xd3\xd3\xbc\x7b\x1d\x33\x72\xd2\x3f\xd5\xcd\xef\xc8\xe1\x96\x44\xe0\xeb\x38\x5e\xdd\x69\x3e\xc7\x6b\xee\x24\xd4\x31\x95\xa0\x65\xc8\xd3\x22\x42\x68\xed\xd6\x75\x47\xf5\x7d\xba\xd3\xe7\xb8\xc4\x7a\xd7\x6b\xa1\xdb\x53\xc8\xb1\x03\x7f\xfa\x3d\x15\xa2\x1b\x72\x92\x16\xae\xca\xa7\x62\x0c\x52\xa9\x8f\xce\x48\xc7\x8f\x2f\xcd\xd5\x4a\x97\x78\x02\x81\x7d\xdf\x8b\x3f\x7c\x9a\xd4\x55\x2a\xaa\x50\xbe\xb5\xc9\x53\x2a\x8b\xa9\x3e\x7b\xa4\x78\x41\xbc\x36\x37\x8e\xb5\x19\xda\xd3\x6f\x94\x6d\xfc\xf0\x58\xf8\x58\x25\x91\x97\xb5\x0d\x7c\x11\xd1\xc4\x34\x8c\x71\x18\xa5\x2c\xa2\x31\xf2\x71\x3a\x15\x20\x63\x6b\x57\x22\x47\xc6\xc3\xcc\x38\x5e\x32\x16\x7e\x60\x3e\x98\x34\xa8\x2b\x6c\xd4\x04\xe1\x3b\xab\x4c\xd4\xc7\xfa\x89\x58\x29\xe3\xef\x76\x90\x3b\x1e\xbf\xeb\xda\x0c\xfe\xe7\x2b\x05\x97\x49\x45\x07\x29\x92\x0e\xa9\x0f\x0f\xe6\x92\x39\x89\xb6\x08\xab\x52\x33\x3a\x18\x94\x88\xba\xd9\x13\xb4\xf4\x01\xdb\x27\xba\x6b\xcb\x1e\x29\x8a\xd5\xbb\x42\x31\xff\x80\xa5\x1a\x11\xee\xde\x97\x36\x34\xb9\xad\xc8\x4d\x8d\xa9\xdb\xda\x8c\xf5\x49\x52\x6c\xfd\x80\x65\x12\x86\xfc\x35\xd5\x87\x12\xaf\xad\xe6\x3c\x3b\x17\xe9\xb4\x4e\x46\x3a\xc6\x9d\x68\x8b\xd4\x59\x63\x48\x3f\xd1\xab\x4d\x6a\xd5\x52\xab\xda\x65\x7f\xea\xa7\xd3\x2a\xf9\xd9\x71\xba\x55\x47\x1c\xdd\x86\xb8\x94\x78\xca\xd8\xec\xca\x96\x90\xf0\x29\x99\x11\x09\xbd\x78\xd6\xc1\x76\xe1\x50\x59\xff\xde\x6e\xcc\x0d\x6d\x18\x64\x58\x7f\x93\xa2\x58\xde\x78\xd0\x20\x45\xfa\x0c\x63\x09\x3d\x74\xb3\x9f\xf0\x5b\xa7\x21\x75\x5d\x0d\x95\x28\x3a\xc5\xb4\xcc\xd5\xa1\x66\x53\x77\xcc\xd1\x97\xb6\x11\x26\x11\x36\x1d\x7e\x53\x2a\x9f\x46\xa5\x29\x54\xa8\xa0\x9e\xe4\xf6\x8a\xed\x16\x98\x2b\xcf\x22\x79\x64\xe6\x77\xdf\x77\x39\x20\xd6\x9d\xc6\x86\x9d\xd5\x8a\x63\x3a\x03\xf4\x37\x01\x7f\xa0\xe3\x7f\xa1\x80\xb1\x10\x0d\x0c\x9f\x95\x11\xfb\xb4\xd4\x35\x9a\xfc\xb4\xb8\x7a\xce\x6c\xd7\x6a\xa5\x76\x59\xaf\xb7\x2e\xdd\xa4\x0c\xb0\x67\xd8\x8d\x1c\xc8\xa2\xfc\x6f\x5f\x91\x51\xa4\xce\x1a\x13\xab\x5a\x7e\xbb\x6d\x6f\x5f\x12\x72\x0e\x47\x17\x8c\xdf\x63\xee\x13\x3a\x4b\xa5\xb3\xda\xfb\xd8\x2e\xee\xe8\xb4\xe9\x17\x6b\xd5\x29\xa6\x9f\x6c\x8b\x7b\xe9\xd8\xb6\x4e\xb1\x67\x8d\x09\xdb\xf4\x9e\x6f\x13\x3c\x36\x36\x9d\x6b\xe1\xd6\xd3\xa2\xd1\x2a\x1d\xbe\x5e\x64\xba\x5c\x6c\x9f\xd2\x6d\x6c\x51\x2d\x78\x63\x75\x6e\xcf\x0c\x97\xca\x31\xa0\x89\x4a\x5d\x2b\x77\xdf\xe2\x80\x8c\xe6\xf1\x3c\x04\x6d\xd7\x04\x8f\x6a\x02\x51\x7b\x83\x74\x6d\x30\x2a\xf1\xac\x78\x49\x50\x66\x39\x87\xd8\x36\x8d\xe3\x2b\xe0\xb8\xe3\xdf\xe2\x7b\x67\x40\x81\x63\xc9\xf8\x29\xf3\x93\x7c\xf7\x25\xf2\x5c\x84\x9c\xec\x2e\x5a\xad\x33\x8e\xa6\x53\xf2\x90\xa0\x54\xd6\xf0\x7c\xa8\x5a\x08\x77\x18\xf7\xe6\x20\x64\x8c\xa7\x31\xab\x3c\xa8\x16\x4f\x75\xe4\x06\xcf\xb4\x55\xc2\x34\x18\x8a\x57\x48\x7b\x81\x0c\xa9\x7d\xd9\xd0\x7e\x4b\xb2\xc5\xc2\xf4\x49\x64\x96\x63\xe8\x03\x95\x44\x3e\x16\x8a\x4e\xd2\x6f\xaa\xca\x9e\x59\x3f\xf1\x28\x24\x2c\x4e\x84\x20\x33\x0a\xbe\x71\x56\xcd\x68\xd4\xf6\x3f\x57\xc5\xbc\xc6\x50\xdb\x5b\x0d\xea\xd4\xa9\xad\x36\xe5\x96\xbe\xc4\xc6\x39\xe6\xfe\x3d\xe6\x90\x6a\x97\x8e\x4f\xf2\x18\xc0\x1e\xa3\xe5\x4f\x01\xec\x2b\xa7\xf6\xa7\x66\x61\xd3\x73\xe8\x91\xaf\x59\x1b\x6e\xd3\x86\x61\xac\xeb\x76\x5a\x8a\xd3\x56\x96\x4f\x0f\x22\xca\x81\x82\xbd\x05\x9d\x89\x1a\x4a\x60\x7f\x41\xe8\x27\xa1\x92\x13\xb3\x46\x1b\xa5\xdf\xeb\x77\x59\x8e\x97\xc8\x02\x7f\x69\xa5\x49\x44\xfb\x17\x90\xef\xf3\x2b\xb6\xc4\x1c\x27\xd1\xc8\x19\x96\x18\xf5\x2a\x11\xa5\x13\x10\x1a\x3d\x34\x5f\x97\x38\x3e\x11\x6a\xeb\x11\x16\xe2\x9e\x71\xff\x24\x92\x73\xa5\x7b\x85\xb5\x88\x9f\x53\x69\x81\xa6\x10\xf3\xfa\x1e\x9e\xf7\xf0\xb8\x45\xf6\x74\x07\x8f\x0a\x75\x4b\xa9\x6d\x94\xad\xa6\xc6\x6d\x57\x88\xc9\x9e\x58\xce\x2d\x93\xdf\xc3\xe3\x08\xcb\xb9\x25\xc6\xb4\xa4\x46\x2d\xa3\x4e\xe5\x01\x2f\x15\x49\x33\x07\xf8\x0e\x8b\x31\x78\x1c\xa4\xa8\x8d\xe4\x1d\x91\x00\x18\x76\xb7\xb4\x4e\xba\x86\x86\xab\x1e\xdd\x56\x5e\x51\x24\xae\xb4\x46\x8e\x7d\x2c\x71\x1c\x8d\x6d\xd6\xe4\xd8\x99\xc2\xc7\xbc\xe1\xf4\x7c\x11\xca\x47\x9d\x62\x1d\x25\x24\x77\xca\xc4\xfc\xf2\x56\x01\x1d\x0c\x7e\x32\x41\x82\x48\x2d\xb0\x6f\x7c\xff\x22\x6a\xd1\x71\xbb\x20\x3d\x5f\xa1\x65\x50\x6d\xab\x38\x25\xc3\x72\x39\xf7\xed\x29\x4c\xc4\x49\x19\x7b\x0e\x53\xe0\x40\x3d\x78\x6d\xc6\x58\x35\xef\xa9\x6c\x21\x96\x2d\x14\xda\xeb\x34\x3f\x99\xdb\xeb\xa5\x09\xdc\x39\xf5\x43\x46\xa8\x14\xbd\x49\xc0\x26\x1d\x77\x39\xf7\x5b\x45\x94\x5b\x12\xb6\xb7\x9c\xfb\x1b\x52\xb4\x4d\x8f\x47\x2a\x35\x07\x87\x2c\xf0\x0c\xae\x33\x02\x9a\x96\x88\x4d\xa7\xc0\x75\x3d\x61\x62\xa8\xa6\x7d\x54\x63\xb6\x66\x05\x65\x23\xc4\xbc\x76\xde\x28\x1b\xb7\xcc\x15\x77\x51\xcd\xac\xf1\x5d\x64\x81\x5f\xda\xd3\x97\x74\x4e\xca\x2e\xbd\xb7\xa3\x42\x01\x26\x94\x5a\x9a\x27\xf7\xb0\x37\x4f\x92\x4f\xe7\x1a\xb0\xff\x2b\x27\x12\xaa\x74\xef\x18\x9a\x7a\xc1\xd9\x22\xde\x78\xeb\xd8\x1c\xbd\xa8\x5e\x32\x61\xd1\xca\x7a\x15\xfb\x0b\x29\xd8\x8e\xf4\x2b\x21\x90\x55\xbb\xd6\xda\x9b\x4b\xd0\xb9\xfa\x71\x7c\x96\x5b\x62\xb4\x6f\xf0\xb4\x62\xa6\x57\xab\x86\xc9\x96\xea\x09\xaa\x96\x7c\x4b\x8e\xa7\x45\x19\x22\x0b\x88\xd3\x87\x5f\x57\xb1\x40\xeb\x45\x88\xc6\xa8\xbf\xe6\xda\x6a\xb0\x7f\x70\xd4\x3d\xd8\xef\xee\x1f\x74\x43\x0e\x4b\x02\xf7\x0d\x17\x55\xe5\x7a\x40\x5d\x2d\xa0\xa2\xd6\x0d\x09\x7f\xe9\xb8\xb9\xaa\xcc\x22\xe2\x5b\xe4\xb2\xe6\xf0\xad\xb2\xfc\x42\x68\xf6\x3a\xee\x72\x91\x25\x3e\x95\xc2\x84\x85\xde\x2a\x4c\x63\x9c\xfc\x19\x47\x69\x7d\xce\x02\x48\xd2\xa1\x05\x50\x29\x36\x5f\x9d\xa8\x09\x67\x30\x25\x94\xa8\xf9\x43\xa3\x26\xe5\x31\x9a\x74\x9d\x32\x7e\xad\x81\xea\xc9\x27\x27\xd4\x23\x21\x0e\x86\x59\x4e\x50\xaf\xbf\x3b\xa2\x93\x4a\xe6\x07\xfb\x07\xff\xe8\xee\x1f\x76\x0f\xf7\xdd\x0e\x72\x2f\xa2\x20\x70\xf7\x7a\x19\xf1\x7a\x25\xa4\x8a\x97\xd0\x65\x51\x2c\x68\xd1\x5e\x96\xfb\xf0\x20\x81\x8a\xf8\xa6\x29\x27\xc0\x73\xcc\x68\x7c\xf5\xa1\x29\xc3\x79\xb6\x47\x85\xcc\x5f\x4b\xd2\x2d\xca\xf7\xa6\xbb\xff\xc6\xa6\x7c\x5a\x45\x21\x4b\x05\xe3\x9a\xe7\xeb\xbd\x5e\x36\x58\x3e\x84\xbd\x70\x66\xad\x9c\xed\x4a\x52\xaa\x24\xb0\x6c\xd4\xa8\x47\x6a\xbb\x17\x56\x79\x54\xd5\xf9\x92\x43\x28\x62\xa7\xda\xb2\x7e\x35\xfa\x29\x90\xd3\x64\xaa\x42\x83\x5c\xdc\x6b\xe4\xee\x82\xf1\x38\xc5\x31\x26\xbd\xc3\xd4\x0f\x80\x97\xa4\xe3\xa0\xb7\x5f\x81\xc2\x91\x64\x9f\xc2\x19\xc7\x3e\x5c\x11\xca\x4a\xa0\xda\x85\x8f\x23\xec\xed\x4a\x79\x9f\xd8\x9b\xfd\xc3\xa3\xc3\x62\xa0\x1c\x44\xc5\x3d\x14\xe0\x49\xf0\xcb\x3d\x4f\xb9\xb3\x4a\x7f\xc9\xd5\x7c\x97\x6d\x5e\xdf\x71\xf5\x79\x53\x41\xed\x25\xb5\xac\x21\xd8\x41\x6e\xc9\x68\x36\x9a\xba\xd2\x49\x8c\x2e\x8d\xaf\x8f\xb8\x86\xd0\xf3\x7b\x25\x9e\xe5\x64\x5e\x2a\x54\xef\x7b\x02\x76\xd8\x8e\x51\x63\x8c\x4e\xfe\x8c\x38\xf4\xce\x2d\xbe\xb3\x20\x4b\x52\xc1\x1a\xc7\x2f\x5f\xf5\x71\xd3\xee\x0c\x2a\x76\xa7\xb5\xd9\xa9\x58\x9d\xb5\xd6\x95\x65\x58\x14\xad\xc2\xb7\xc0\xd4\xbf\x61\xe7\x0f\xe0\x45\xb2\xc2\x0b\xb7\x1f\x09\xde\x9f\x10\xda\xa7\x6c\x1e\x85\x28\xfe\x75\x82\xc5\x1c\x75\x3d\xf4\x9b\x53\x7c\xec\xb3\x50\xf6\xb1\x22\x46\x5f\x45\x57\x98\x50\xe0\xa2\x1f\x72\xb6\x24\x0a\xdd\x9e\x98\xa3\x4a\x8a\x21\x81\x62\x2a\x93\xd6\x81\xea\x88\x88\x26\xf9\x23\x61\x15\x8f\xe9\xe3\x15\x5f\x6c\x0e\x17\x02\x6a\x59\x38\xff\x13\x1b\xfa\x58\xfe\xb7\x12\xf4\x01\xdb\x5f\x1f\x69\x86\xb9\x2e\xe3\x67\x9f\xa0\xbf\x52\xd5\xc7\xd3\x44\x4d\xab\xad\xdb\x61\x05\xf0\x25\xf1\x60\x94\xc5\x84\xa7\x01\x01\x2a\x6d\x84\xb3\x43\x26\xd5\x39\x13\xda\x8b\x47\xd3\xf6\x88\xf7\xf0\x68\x42\x48\xcc\x67\x20\xcf\xe9\x92\x70\x16\x47\x14\x16\xb2\xa6\xa5\x79\x16\x10\x2f\x5b\xa1\x6c\xc3\xa6\x7f\xf8\x34\x4b\x47\xb3\x2b\x24\x83\xba\x14\xe4\x29\x25\x49\x65\x32\x88\x66\x84\x8a\x4f\xd7\x97\x16\x84\x29\x69\x1a\x5e\xe0\x87\x11\xf3\x85\xed\xa0\x2c\xf2\x47\x4a\x50\xfd\xa4\xf7\x89\x4d\xa7\xed\xa0\xae\x41\x72\x02\x2d\x97\x3c\x7f\x08\x19\xb5\x12\xc9\x06\x7d\x96\x16\xb4\xdb\x41\xff\x9b\x48\x09\x7c\x03\xec\x35\x96\x10\x90\x05\xd9\x84\x41\x0e\xf7\x9f\xd1\xb8\x2d\xe8\xdb\xc8\xbb\xb3\x09\x51\x64\xf8\xec\x92\x5b\xb4\x00\x0f\xa9\x90\x98\x7a\x70\x05\x12\xfb\x71\x59\x5c\x07\xc2\x21\x49\x9e\xdd\x34\x49\xa6\x87\x4f\x95\x09\x9f\x12\x0f\x4b\x8b\xca\x78\xb8\x69\xb2\xd9\x35\xae\x43\xdc\x45\x13\x48\xae\x1d\x1a\xb7\x29\xc0\x9a\xb6\x2b\x2e\x5e\x3a\x2e\xfa\xf9\x67\xd4\x5f\x62\xde\x0f\xd8\x2c\x33\xa6\x41\xa4\xd0\xe9\x16\x96\x34\x60\x33\x34\xf8\xf9\xef\x07\xbf\x39\x5a\x57\x45\xf9\xff\xf5\x6a\x15\x97\xd9\x2e\x09\xbd\x03\xff\x06\x16\x61\x80\x25\x88\x0b\xc6\x0b\x57\xb5\x5e\xbf\xfa\x6f\x00\x00\x00\xff\xff\x68\x1d\x82\x8e\xaf\x4f\x00\x00")
+var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x1c\x69\x6f\x1b\x37\xf6\x73\xfb\x2b\x06\xda\x62\x95\x14\x3a\x7c\x24\xd8\x6e\x80\x2d\xe0\xf8\x48\xb4\xb5\x1d\xad\xe5\xa4\xc0\xa6\x46\x41\xcd\x50\x32\xd7\xa3\xe1\x94\x9c\x71\xec\x08\xfa\xef\xfb\xc8\xb9\x78\xcd\x68\x64\xcb\x49\x83\xba\x47\x62\xf1\x91\x7c\x7c\xf7\x7b\x7c\xd4\x72\x49\x66\xde\xe0\x0c\xf1\x04\xb3\x31\xa3\x33\x12\xe2\xc1\x88\x9f\xa1\x08\xcd\x71\x70\x44\xf8\x0d\x5f\xad\xbc\xef\x3d\xf8\x59\xca\xff\x7b\x5e\x07\xc5\xe4\x03\x66\x9c\xd0\xa8\xf3\xca\xeb\x7c\xbc\x45\x8c\xa0\x69\x88\xf9\xb3\x6e\x35\x32\x49\x28\x83\x15\xd4\x75\xba\xcf\xaf\x3a\xbd\x62\x8d\x90\xfa\x28\x71\xac\x50\x7c\xae\x01\x47\x68\x81\x4d\xc0\x85\xc4\xf8\xe0\x16\x91\x10\x4d\x49\x48\x92\xfb\x09\x4e\xb4\x59\x31\xa3\x31\x66\x09\xc1\xbc\xf3\x2a\xff\xac\x3a\x44\x01\x13\xa2\x64\x46\xd9\xe2\x04\xa5\x61\x72\x44\x17\x88\x44\x87\x34\x8d\x12\xb1\xdb\x5e\xb9\x94\x01\xfc\x3e\x0e\x50\x82\x0d\xe8\x7d\x80\xfe\xee\xbb\x12\x76\x91\x1d\xbc\xe3\xc1\x50\xc2\x52\xdc\x29\x97\x5a\x95\x08\x26\xf7\xb1\x3c\xd6\x19\xf1\x19\xe5\x74\x96\x0c\x0e\xe9\x22\x4e\x13\x3c\x44\xfa\xb1\x78\x36\x1b\x66\x2e\x97\x38\xe4\xd8\x73\xb1\x2c\xa7\xf8\x81\xef\x0b\x94\x56\xab\xcd\x79\x76\x84\x67\x82\x0c\x5f\x93\x4f\xde\xf2\x51\xe4\x79\xa8\x98\x16\xf8\x2c\x1d\xca\x30\x89\x19\x46\x81\x4e\x5d\x9e\x93\x17\x76\xf1\x69\x7c\x2f\xf0\x2e\xf9\xdb\xf1\x0b\x91\xf8\xc8\xd3\xe9\x33\x8b\x14\x52\x62\xba\xcf\x7b\x9e\x35\xf2\x6e\x36\xe3\x82\x36\x0a\x71\x14\xa2\x66\x30\x3a\x1e\xa7\x94\xc6\x85\x64\xad\xb2\x03\xe0\x28\x90\xc8\xc9\xa3\x44\x34\x31\x8f\xf3\x16\xf1\xe3\x3b\xc2\x13\x12\xcd\xc7\xe9\x34\x24\xfe\x68\x5c\x1d\x26\xc0\x31\xcc\xe7\xef\x04\xc5\x3e\x56\x28\x7c\xf4\x69\x04\xec\x7e\xd6\xad\x58\x71\x8e\x93\x4f\x94\xdd\x0c\xe3\x7c\x8d\x83\x20\x60\x98\x73\xcc\x87\x5d\xc7\xc9\xc6\x3a\xd4\x39\x1c\x49\x1e\x33\xdf\xe2\x4a\x47\x7d\x23\xc1\x7b\x00\xcb\x4a\x31\xcd\x8f\xa5\xac\xce\xb5\x39\xaf\x11\xc7\x19\xae\x3d\xaf\xbb\xe0\x09\x83\xb3\x09\x7e\x8f\xa2\x00\xdf\xd9\x9c\x2d\xf9\x57\xa2\x26\x94\xd5\xb1\xaf\x35\x53\x47\x35\xdb\xb2\x5c\x43\xa5\x8a\xae\x2b\x15\x87\x50\x36\xf3\x32\x57\x19\x75\x87\xdb\xc5\x84\x7c\xc6\x60\xd1\xe3\xee\x73\x7b\xe7\x0f\x67\x62\x14\x76\x1b\xe8\x47\x17\x2b\x5d\x69\xa2\x55\xa3\x93\x39\xea\x43\x7d\xba\x66\xb1\xe4\x01\xea\xe4\x71\xc4\x0f\x53\x98\xbb\xf8\x70\x7e\x7c\xb9\x2d\xa3\xb5\xb9\x18\x47\xd9\x9f\x13\xec\xa7\x0c\x8c\xca\x1b\x46\xd3\xd8\x14\xe5\x88\xcf\x2b\xc1\x2d\x8f\x33\xe2\x02\xf3\x51\x94\xe0\x39\x03\xaf\x50\xf1\xca\xf3\x7a\xad\xb6\x86\xad\x12\x7c\x29\xf7\x30\x36\xac\x46\xd4\x7d\x55\x79\xb8\xda\x9e\x9d\xbe\x25\x2c\x49\x51\x98\x63\xa5\x8a\x60\xb3\xe0\x65\x1a\x3d\x89\x91\x8f\xb5\x91\x6a\x6c\xcc\xf0\x8c\xdc\xc9\x89\x1f\x95\x61\xcf\xd8\x1f\x58\x70\x48\x02\xd6\xad\xcc\x82\x3c\xa1\xed\x37\x61\x22\x18\xd6\x48\xd8\x7d\x7d\x45\xc3\xb7\xbb\x4e\x99\x4d\x34\x4f\xd7\x7c\x46\xd7\x69\xdc\xeb\xda\x6b\x0a\x34\x1c\xa2\xe5\x58\x1f\x20\x49\x60\x2e\x0b\x22\x37\x3a\x32\x28\x22\x69\xd1\x4a\xfe\xb2\x1f\x0b\xa1\x4a\xac\xda\xa2\x51\xcd\xa8\xc5\x46\x95\xca\xe2\x53\xd7\xdf\x0b\x7e\x36\x99\x94\x42\x33\x74\x91\xb4\x4d\x8a\xf8\xf5\xeb\x07\x38\xa5\x59\x68\xa1\x2d\x3c\x17\x82\x8b\x34\xcc\xf5\x21\x73\x5f\xe0\x94\x7f\x25\x51\x40\x3f\x71\x8d\x88\x35\x02\x8d\xc2\x90\x7e\xfa\x9d\x05\x71\xa7\xe7\x6d\x24\xc1\xbe\x0f\x02\x2c\x56\x38\x10\x2b\x98\xb3\xa5\xe1\xe4\x3e\x23\x71\x41\x0f\x09\xe6\x5d\x1c\x8d\xbd\x84\xa1\xd9\x8c\xf8\x5e\x42\xbd\xcc\x6f\xb8\x27\x43\x54\x21\x89\x76\x60\xea\xca\x8f\xcd\xf0\x63\xca\x92\x0b\x14\xcd\xe5\xf1\xf6\xf7\x7f\xfa\x67\x5f\xfc\xcf\x35\x87\x30\xec\x17\xe8\x8d\xa2\x29\xf8\x9a\xc0\x01\x16\x33\x42\x05\x9d\x01\x6a\x77\x67\xcf\x35\x4e\x13\xea\xd3\x50\xac\x72\xe9\x5b\x74\x14\x9c\xa2\x29\xf3\x71\xab\x73\x64\xa0\xda\x11\x7e\xec\xd4\xab\x42\x29\xbf\xf9\x07\x6d\xf9\xcd\xf9\xf5\x86\x06\xcb\x64\x77\x2b\x6e\x4f\x26\x6f\x5d\xdc\xde\x90\xd9\x6d\x79\xbd\xb7\xd7\xdf\x33\xf3\xac\x5a\x36\x37\x72\x79\xd7\x31\x6c\x30\xb9\x3d\x8f\x1f\xcd\xe2\xea\x97\x46\x9e\xde\xa4\x53\xfc\x7b\x12\xf2\x2f\xc1\x58\xb1\x57\x1f\x6c\x21\xc7\xec\x16\x33\xef\x19\x6c\xfb\xfc\x0b\x72\xfa\xc5\x8b\xfd\x3e\xfc\xb7\x15\x5e\xef\xfc\x89\x78\xfd\x20\xcf\xe6\x0c\x37\x15\xff\xd6\xec\xdb\xbf\xbe\xcf\x33\x23\x53\x05\xbc\xfe\xd0\x4a\xa0\xbb\x75\x57\xfe\x57\xc9\x76\x6b\x39\x92\xed\x7c\x3a\x6d\x1d\x8a\x4c\x91\x7f\x03\x28\x14\x1a\x41\x69\xf8\x80\x70\xba\xd8\xf5\x75\xb6\x98\x58\xa5\x40\xc0\xad\x23\x4a\x0c\x3f\x63\x14\x04\x3b\x0a\x46\xe3\x43\x1a\xcd\xc8\x3c\x65\xf2\xa4\x8f\xc0\xa2\x58\xe9\x41\xe1\xbd\xc1\x5a\x09\xe2\xaa\x2d\x34\x49\x94\xb2\x9c\x1d\x44\x3b\x65\x23\x8f\xa7\x8d\x4a\x81\xbd\x0e\xc0\x4a\xb3\x34\x0a\x5a\x89\x65\xb7\xd7\x5e\x28\x5d\xb1\xbb\x6e\xe3\x6a\x2d\x9e\xc2\xcd\x90\xa2\xe0\x35\x0a\x51\xe4\x03\x61\xaa\xf0\x76\x1d\x1b\x4f\x5f\x0b\xd8\xb7\x97\x97\xe3\xc9\x66\xec\xaa\x91\x9e\xb6\x19\x8d\x29\x32\xee\xbc\xc6\xf2\x0d\xb6\xd2\x34\x6e\x68\xd7\x97\xca\x7d\x8f\x64\x4d\x69\xe8\xd0\x42\xa7\x49\x71\xa8\x58\x1b\x7c\x55\xb7\x98\xb8\xdc\x62\x41\x46\xe1\xee\x00\x08\x1c\x74\xdd\x99\x1b\x20\x70\x24\x70\x3d\x01\x11\x10\x5a\x31\x1a\x03\xd8\x0c\x81\x3c\x5b\x80\x24\x08\xf1\x25\x59\x60\x70\x04\xa3\xe8\x8c\x44\xe0\x0f\x04\x73\x5f\x5a\x80\x42\x9a\x8e\x40\xc9\x18\x99\xa6\x85\x59\xcc\x2d\xbe\x2b\x34\xa0\xd3\xe6\x54\x76\x0d\x1f\xba\x43\xb9\x04\x1f\x02\x89\xa4\x28\x8e\xc5\xaf\xce\x44\xb7\xee\x37\xb7\x52\x64\xcb\xb6\x33\x68\xda\xde\x1b\x9a\xae\x75\x5c\x8e\xeb\x79\x47\x80\xfd\xec\x16\x85\xa3\x08\x82\x11\x0a\xde\x50\x2c\xf2\xd2\x51\xc5\x48\x17\x53\x51\xe1\x1c\x17\x47\xea\xec\x6d\x3b\x28\xaa\x4c\x08\xb8\x78\x3d\x18\x9a\x5b\x8e\x5d\x56\xd1\xbd\xdd\xa7\xa9\x18\xba\xec\xbe\xa3\x4e\x29\x67\xba\xcb\x35\x96\x41\xb7\x6a\x5d\x15\xe0\xd3\xd4\xf3\x32\x09\x17\xb1\x23\x8b\x50\xf8\x57\x0e\x0c\x2a\x1a\x3c\x2e\x40\x60\xe4\x16\x82\x70\x35\x42\xd0\x36\x13\xf9\x15\x03\xc6\x62\x7e\x30\x1e\x4d\x64\x92\x35\x1a\x3b\x2b\x82\xd5\x4a\x61\xc1\xce\x33\x9c\x5c\x53\x69\xac\x26\x09\x7c\xe0\x3b\xd2\x12\x59\x61\x6c\xeb\xdf\x84\x84\x4d\xe4\x8c\x9a\x8a\x5d\xdd\x6f\x0f\xf3\xee\x75\xcc\x28\x49\xff\x50\x37\xbf\x25\x87\xab\x88\xc0\x97\x71\xbc\xa6\xd3\x7c\x8c\xd7\xdc\x4a\xa8\x63\x2b\x41\xcb\x90\xa7\x45\x84\xd0\xda\xad\x9b\x8e\xea\xcf\xe9\x4e\x1f\xe3\x12\xeb\x5d\xaf\x83\x6e\x0f\x21\xc7\x16\xfc\x69\xf3\x7d\x5c\x7d\x7e\xf3\xf5\xcb\x0e\x0d\x99\x4c\x0b\xc7\x16\x44\x7c\x82\x13\x71\x30\x93\xed\x9d\x40\xf6\x72\x88\x95\x4e\xd1\x14\x87\xee\x7d\x4f\xfe\x08\xa2\xac\x72\xa4\x29\x4e\x11\xa3\x88\xa6\x19\x01\x76\x74\x3e\xf9\x2f\x8d\xf0\xc1\x05\x08\x0f\x53\x13\xab\x2a\xc5\xac\x37\xfb\x8e\xc0\xa1\x69\xd6\xd1\x3d\x90\x29\x9f\xa6\x84\x11\x6d\xa4\xc3\xca\x19\xdd\x12\x62\x4b\x47\x7e\x3e\xed\x78\x1b\x8a\x46\x1c\xd2\xfb\x05\x8e\x12\xde\x10\x83\x95\xe7\x77\xa6\x6d\xaa\xed\xba\xb2\x65\xa6\xce\x0f\xa8\x2c\x04\x2f\x50\x95\x43\xfb\x20\x1c\x7d\xe3\xba\x17\x16\x9b\xa4\x33\x09\xab\xa2\x59\xa4\xe2\xc5\x45\x5e\x67\xb9\x34\x88\xa4\x51\x68\x90\xfd\x55\x99\xb3\x5a\xad\x97\xd5\x05\x0d\x72\x07\xea\x33\x2c\x48\x85\x42\xb5\x27\x24\xc1\x0b\xd1\x8c\x64\xdd\xb8\xfe\xc0\xfd\x6b\xbc\x40\x62\xe6\x75\x92\xc4\xfc\xd5\x70\x98\x7d\x32\xc8\xfa\x91\xc4\x4a\x03\xf4\x39\x65\x78\xe0\xd3\x45\x3e\xc6\x87\x7b\x3b\xbb\x2f\xfb\x3b\xbb\xf0\xef\x30\x28\x99\x73\x99\xef\x31\xf8\x1f\xa7\xd1\xdf\x34\xcb\xd6\xf1\xa5\x4f\x48\x14\x56\xef\x0e\x76\xc4\x3f\x3a\x58\x41\x2a\xfb\xea\xd7\xae\x69\xb7\x11\x9c\x8c\xae\xdc\x19\x54\x15\xbc\x6f\xc1\x0d\xa1\xe7\xab\xd5\x70\x0d\x64\xf6\x47\x06\xdb\x69\xab\xe3\xcd\x6c\x2d\xc7\x2f\x2f\x4f\x61\x60\xdf\x2e\x64\xc3\x58\xbe\xa8\x4d\x33\x37\xe5\xf2\x59\x24\xbe\x7d\xa1\x06\xa6\x20\xe4\x98\x61\x30\xfd\xb6\x12\x38\x4a\x50\x9a\xe0\x3b\x8c\xf8\xf3\x01\x89\x73\x70\x2b\x64\x10\x3f\x76\xe1\xea\xca\xf8\xc4\x0e\x2c\xea\x4d\x13\xe8\xe2\x67\xc1\xe7\xe1\x41\x6d\x65\xec\x51\x14\x3e\x3c\x3f\x38\x3b\xce\xa8\xec\x9c\x2c\x04\xbc\x34\x25\xdb\x26\xa4\xe2\x85\x06\x33\x30\x47\x0e\x7a\x5a\xb5\xb8\x87\xd0\x4e\x1e\xd2\x5d\xdd\x53\x7f\x53\xfa\x2b\x5a\x38\x8f\xc2\x8e\x71\xc5\x4e\x3c\xd1\xbd\xfc\x17\xee\xab\x3b\x9d\x8a\x5e\xba\x73\x61\x76\x2d\x3a\x3c\xde\x31\x3d\x2c\xf2\xa9\x2f\x5b\x95\x4d\x69\x22\x67\xe9\x4e\x26\x6f\xfb\xae\xdc\xe5\xc3\x99\x80\xab\xfc\xdd\x06\xad\x6b\x12\x91\x35\x15\x82\x3c\x23\xd8\xdb\x53\x88\xba\x3e\xa9\x69\x99\xce\x6c\x5e\xb3\x5d\x39\xf6\xc8\x51\xd4\x5b\x75\xf8\xf5\x39\x4a\xc4\x08\x38\x92\x8f\x6d\x68\x72\xa5\xc9\x4d\x4d\xd4\xbe\x71\x44\x3e\x24\xd9\x5d\x27\x20\x93\x65\xd4\xdf\x2b\xcb\x7c\x3b\xea\x13\x11\xbf\xad\xe6\x3c\xba\xac\xd6\x6b\x5d\x57\xeb\x59\x86\xaf\x45\x15\xd8\x60\xc8\x30\xd3\xab\x75\x6a\xd5\x52\xab\xda\x15\x32\xc5\x4f\xaf\x55\x1d\x6f\xcb\x95\xc3\x3a\xe2\x98\x36\xa4\x0b\xfc\xee\x6f\xd6\x06\xdb\x68\x4b\x48\xfc\x90\x22\x1f\x89\x7d\x39\x6b\x77\xb3\xcc\x5e\xd5\xbf\xd7\x6b\xcb\x9c\x2e\x0c\x0a\xac\xbf\xca\xfd\x4e\xd9\xf7\xd7\x20\x45\xe6\x0c\x6b\x09\x3b\x1e\x72\x46\x94\x5f\xb9\xa2\x56\xd7\x54\xa8\x15\x84\x72\x4c\x55\xae\x8e\x0c\x9b\xba\x65\x8e\x3e\xb5\x8d\xb0\x89\xb0\xee\xf0\xeb\xaa\xd2\x79\xa4\x9a\x43\xc9\x20\xfe\x41\x6e\xaf\xda\x6e\x81\x98\xf0\x2c\xe2\x51\xcb\x37\x56\xd9\xce\x53\xb8\x86\x7e\xd9\xe5\x92\x89\x9e\x1f\xef\x07\x8e\xff\xf0\x5e\xfd\xcb\x0b\xc1\xb3\x79\x7b\x96\xcf\x2a\x88\x7d\xa8\xbc\xb4\xc9\x7e\x5a\x74\x7e\x15\xb6\x6b\xb9\x14\xbb\x28\xc5\x80\x92\x86\x6b\x6e\x21\x72\x06\xb8\x8b\xc5\x8d\x1c\x28\xca\x45\x5f\xff\x72\xa1\x6a\x80\x31\xb5\xfc\x6a\xd3\xd6\xfa\x2c\xe4\x1c\x8d\x4f\x28\xfb\x84\x58\x00\x61\x67\x2e\x9d\x8d\x85\xac\x9a\xb8\xa3\xd7\xa6\x5d\xbb\x55\xa3\xb6\x79\xb2\x0d\xda\xc2\xa4\x6d\x9d\x21\xdf\x19\x13\xba\x0f\xa3\xbf\xd7\xdb\x24\x78\x6c\x7c\xa8\x67\xbe\x60\x79\x50\x34\x6a\xb4\x3a\x7d\xb1\xc8\xf4\x76\xb1\x79\x4a\xb7\xf6\x85\x48\xc5\x1b\xa7\x73\x7b\x64\xb8\xa4\xc6\x80\x36\x2a\x75\xcf\xdf\x86\x0e\x07\x64\x3d\xb8\x2b\x43\x50\x14\x05\xeb\x1e\x0f\x6e\xf6\x9e\xaa\x06\xdb\x9a\x87\x41\x82\x6c\xcb\xe5\x1b\x9c\x9c\xd5\xbc\x7c\x5a\xad\xaa\x0b\xf4\xf6\x0f\x1d\x73\x34\x5a\xbe\xac\xaa\x0d\x9e\x13\x34\xaf\x5e\x8b\xaa\x22\x0a\x04\x10\xb6\x74\x22\xcb\x1f\xf2\x55\xa7\x23\x56\x98\xe3\x08\x83\xa5\xa2\x20\xb2\x41\x96\x9f\x3f\x45\x5e\xae\x14\x56\xcf\xcb\x1a\x75\x86\x52\x4d\xf9\x5a\x9b\x4b\x99\x7f\x8d\x79\x22\xf1\xb4\x66\xa9\x83\x62\xf1\x5c\xa7\x2f\xd1\xdc\x58\x25\xce\x83\x37\xb9\x42\xde\x3a\x6c\x69\xd9\xd3\xa6\x22\x1b\x92\x4d\x0a\xff\x7b\x5e\x58\xba\x51\x80\xa3\x04\x94\xa3\x32\x4c\x24\xff\x44\x37\x4e\x85\xb5\xe6\xf7\xb0\xf0\xe2\x80\x73\x32\x8f\x70\x60\x9d\xb5\xed\x3b\x3d\x5d\x2d\x6b\x1c\x8b\xbb\xdb\xaf\x4e\xfd\xdb\x6a\x7f\xb1\x8b\x5a\x28\xb9\x06\x8f\x09\x5e\x13\xe7\xda\x65\xe2\x93\xbd\x1d\x74\xc7\x94\xe5\xcb\x41\xf7\xca\xb9\xbd\xac\x59\xd8\xf6\x74\x6b\x6f\x08\x5a\x75\x42\x5a\xeb\x76\x7b\x2d\xc5\x69\x23\x4b\x6d\x06\x3d\x35\x15\x55\x55\xeb\x78\x0d\x25\x50\xb0\x20\x11\x88\x25\x73\x5d\x78\xa6\xf9\xe7\xe6\x8d\x07\xb8\x4c\x29\x0b\xec\xa9\x95\x46\x72\x42\x58\xeb\x5f\xca\xee\x96\xcc\x1c\x67\xd1\xd3\x11\x4a\x90\x37\xd0\x22\xe0\x4e\x48\xa2\xf4\xae\xb9\x53\xa1\x13\x10\x2e\xb6\x1e\x23\xce\x81\x5d\xc1\x41\x9a\x5c\x0b\xdd\xab\xac\x85\x7c\x32\x6f\x04\xc6\xe2\x01\x4c\x6d\xe3\xee\x2f\xf8\x7e\x83\x6c\xef\x06\xdf\x0b\xd4\x1d\xa5\xc1\x71\xb1\x9a\x18\x77\x5d\x34\x65\x7b\xa2\xe4\xda\x31\x19\xa6\x8d\x61\xc4\x11\x13\x3b\x52\xb9\x96\x51\xb2\xf0\x80\xa7\x82\xa4\xca\x15\x2c\x04\xa7\x0c\x27\xbc\x36\xf3\x10\xef\xcc\x58\xf6\x3e\x52\xb7\xbb\xca\x3a\xf9\x1a\x06\xae\x66\x34\xae\x3d\xba\xcc\x5c\x69\x8d\x1c\x07\x40\x2f\x19\x3d\xae\xd7\x64\xe9\x4c\xf1\xbb\xf2\x7d\xca\xf1\x22\x06\xab\x6b\x40\xf5\x84\x90\xdc\x08\x13\xf3\xe6\xb5\xbc\x60\xdc\xfb\xc9\x06\x09\x53\xb1\xc0\x8e\xf5\xf9\x93\xa8\x45\xaf\xdb\xc7\x89\x1f\x08\xb4\x2c\xaa\x6d\x14\xa7\x14\x58\xde\x5e\xbb\xef\x9f\x3a\x90\x79\xa8\xd8\x57\x57\x50\x9b\x44\x59\xeb\x82\xac\x5e\x53\x3a\x20\x6e\xaa\xf2\x54\xf3\x38\x0a\x62\x4a\x60\xcd\xc1\x34\xa4\xd3\x5e\x17\xb0\x6e\x15\xfb\x6e\x48\xd2\x01\xac\xbb\x26\x99\x5c\x7b\x97\xa5\xe9\x00\x20\x3f\xc7\x17\x05\xe9\x6c\x1b\x44\x67\x30\x64\x6a\x08\xe5\x23\x31\xed\x9d\x18\x73\x75\x08\x0a\xeb\xc0\xaf\x6b\xe7\x8d\x8b\x71\xc7\x5c\x7e\x93\xd6\xcc\x9a\xdc\xa4\x0e\xf8\x5b\x77\xa2\x95\xcf\xc9\xd9\x65\x36\x54\x6a\x14\xa0\x5c\x28\xa4\x7d\x72\x1f\xf9\xd7\x59\x9a\xdc\xb9\x80\xb8\xfe\x57\xc8\x73\xb1\x4e\xf7\x9e\xa5\xa3\x27\x8c\x2e\xe4\xc6\x9d\x9a\x74\xba\x51\xda\x9f\x4e\x23\x29\x77\xe8\x63\xbd\x72\x7d\x13\xaa\xb5\x25\xcd\xca\x48\xe3\xd4\xab\x95\xd1\x06\x86\x4d\x7e\xbe\x9b\x1c\x95\xd6\xd7\xdb\xb1\xb8\xa9\x99\x66\xab\x8f\x42\x9d\xec\xa8\xf0\x88\x1f\xa7\xbb\x6b\x53\x2a\x29\x82\xe0\xfc\x6d\xf8\x99\x14\x65\xb3\x50\xd2\x18\xe9\xd7\x5c\xad\xed\xed\xec\xbe\xe8\xef\xee\x88\x36\x18\xc8\x76\x6f\x09\xfe\xd4\x70\x99\xa6\xd6\x2c\xea\xea\x15\x9a\x42\x37\x14\x25\x94\xe3\x96\x4a\x32\x4f\x49\xe0\x90\xc8\x9a\xc3\xb7\xaa\x44\x54\x42\x03\xb2\x71\xbb\x28\x92\x1d\xbd\xb7\xc9\xa6\xb7\x08\xcd\x28\x23\x9f\x65\x64\x36\x64\x34\xc4\x59\x0a\x94\xdd\xfc\xaf\xbd\xde\x11\x13\x8e\x00\x85\x88\x88\xf9\x23\xab\x6e\x26\x1a\x89\xe4\x23\x0f\xca\x2e\x0c\x50\x33\xe1\x64\x24\xf2\x49\x8c\xc2\x51\x91\x07\xd4\x6b\xee\x96\xe8\x24\x12\x78\x10\x8b\x7f\xf4\x77\xf6\xfb\xfb\x3b\xe2\xa6\xfd\x24\x0d\xc3\xee\xf3\x41\x41\xbc\x81\x82\x54\xf5\x65\x29\xaa\x28\x56\xb4\x68\x2f\xcb\x43\x7c\x97\xe0\x88\xcb\xdb\xb0\x92\x00\x8f\x31\xa0\xf2\x7a\xc6\x50\x86\xe3\x62\x0f\x8d\xcc\x5f\x4a\xd2\x1d\xca\xf7\xb2\xbf\xf3\xd2\xa5\x7c\x46\x15\x41\xeb\xbe\x7b\xf6\x7c\x50\x0c\xaa\x87\x70\x17\xf7\x9c\xd5\xbd\x6d\x49\x8a\x4e\x02\xc7\x46\x8d\x7a\x24\xb6\x7b\x62\x95\xf7\x74\x9d\x57\x1c\x42\x15\x35\xd5\x5e\x3d\xe8\x71\x4f\x85\x9c\x21\x53\x1a\x0d\x4a\x71\xaf\x91\xbb\x13\xca\x64\x5a\x63\x4d\x7a\x8b\xa2\x20\x84\xc3\x68\xbd\x85\x1a\x14\x02\x73\xf1\x3e\x9e\x33\x14\xe0\x33\x12\x51\x05\xd4\xb8\x94\x82\x3c\xc8\xd9\xef\x5b\xb6\x65\xbf\xdc\xd9\x7f\xb1\x5f\x0d\xa8\xe1\x93\xec\xf3\xc0\x7e\x82\x03\xb5\x69\xb8\x74\x56\xf9\x5f\x4a\x35\xff\x86\x5b\x9f\x0e\x39\xde\xb4\x50\xbe\xae\x96\xf6\x94\xca\xd6\x10\xf3\x80\x99\xab\x6c\x67\xa3\xc5\x53\x4e\x62\x35\x94\x7c\x79\xc4\x0d\x84\x1e\xdf\xd6\xf1\x28\x5f\xf3\x54\xb1\xfa\xd0\xe7\x78\x8b\x9d\x23\x35\x36\xe9\x40\x76\x37\x1f\x3b\x5c\x68\x45\x96\xac\x78\x35\x91\xdf\x91\x61\x8e\xdb\xe6\x67\x4f\x33\x3f\xad\xad\x8f\x66\x7c\x56\x5a\x24\xe3\x30\x2c\x46\x71\x6f\x01\x58\x5c\xd2\xe3\x3b\xec\xa7\x89\xc6\x8b\xee\x30\xe5\x6c\x38\x25\xd1\x30\xa2\xd7\x69\xec\xc9\xbf\x4e\x11\xbf\xf6\xfa\xbe\xf7\x5b\xa7\xfa\x75\x48\xe3\x64\x28\x5b\xbd\x87\x22\xc8\x42\xc0\x6d\xc6\xc5\x43\xd7\x5b\x22\xd0\x1d\x00\x88\x96\x69\x00\xbd\x50\x94\x64\x5d\x0e\xfa\x08\x98\x9c\xf2\xeb\x44\x44\x58\x66\x8e\x6b\x2e\xd9\x1e\xae\x04\xd4\xb1\x70\xf9\x65\x5c\xe6\x58\xf9\xad\x4a\xe6\x80\xeb\x7b\xca\x9a\x61\xb4\xe6\x7b\xf7\x04\xf3\xfb\x2c\xcc\xf1\x3c\x5f\x33\xca\xea\x6e\x58\xf1\xa0\x80\xf8\xa0\x1d\x79\x68\x78\x18\x12\x30\x40\x2e\xc2\xb9\x21\xb3\xc2\x9c\x0d\xed\xcb\xd1\xbc\x93\xe3\x17\x7c\x6f\x43\x24\x88\xcd\x71\x72\x1c\xc1\xf1\xa9\x0c\x2c\x1c\x64\xcd\xab\xf2\x34\x24\x7e\xb1\x82\x6a\xc3\x44\x47\x72\x91\x95\x16\xb7\x47\x16\x75\xc5\x37\xb5\x45\x24\x2b\x4a\x86\xe9\x9c\x44\xfc\xfd\xc5\xa9\x03\xe1\x88\x34\x0d\x2f\xd0\xdd\x98\x06\xdc\x75\x50\x9a\x06\x63\x21\xa8\x41\xd6\xa6\x45\x67\xb3\x76\x50\x17\x18\x92\x09\xdc\x72\xc9\xe3\xbb\x98\x46\x4e\x22\xb9\xa0\x8f\xf2\x5a\x76\x3b\xe8\x7f\x93\x24\x11\x45\xa0\x46\xd8\x0b\xe0\x63\x48\x16\x64\x1d\x06\x25\xdc\x7f\xc6\x93\xb6\xa0\xaf\x53\xff\xc6\x25\x44\xa9\xe5\xb3\x15\xb7\xe8\x00\x1e\x45\x3c\x11\x8d\x55\x67\x38\x41\x81\xac\x88\x9b\x40\x10\xca\x64\x8f\x5d\x9b\x24\xd3\x47\x87\xc2\x84\xcf\x44\x85\xdf\xa1\x32\x3e\x6a\x9a\xec\x78\xba\x63\x40\x88\xa7\xb7\xd9\x8d\x43\xe3\x36\x15\x58\xd3\x76\xd5\x9d\x0b\x8c\xfc\xfc\xb3\x37\x84\xb1\x61\x48\xe7\x85\x31\x0d\x53\x81\x4e\xbf\xb2\xa4\x30\xe6\xed\xfd\xfc\xf7\xdd\xdf\x3a\x46\x03\x88\xfa\xe7\x6a\xb9\x94\x75\x36\xd0\x9a\x1b\x1c\x14\x6f\x6b\x38\x44\xc1\x95\xab\x02\xaf\xff\x7f\x2d\x82\x17\x18\x8e\x59\x00\x00")
 
 func kubernetesmasterresourcesTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1197,7 +1197,7 @@ func kubernetesmasterresourcesT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5b\x6f\xdb\xba\x93\x7f\x3f\x9f\x82\x30\xfa\x87\x92\x85\xed\xd8\x8e\x9b\xa6\x39\xe8\x43\x6e\x4d\xbd\xb9\xd4\x27\x4e\x7a\xb0\x68\x83\x05\x2d\x8d\x6d\x6e\x64\x52\x25\x29\x27\xae\x91\xef\xbe\x20\x75\xa3\x24\x4a\xb6\xd3\x9e\xee\x02\xbb\x7d\x20\x1a\xf3\x37\xbf\x19\x92\xc3\xe1\xf0\x22\x84\x10\x6a\xcc\xf1\xf3\x97\x6b\x31\x04\x3e\x64\xcc\x6f\x1c\xa1\x6e\xa7\xd3\xfc\x43\xd7\xe0\x80\x8c\x80\x2f\x80\x9f\x02\x97\x64\x42\x5c\x2c\xa1\x71\x84\x1a\x5f\x03\xcc\xf1\x1c\x24\x70\xb1\xe3\xd8\x40\xce\xee\x43\xa3\xf9\xc7\x6a\x85\xc8\x04\x51\x26\xd1\x40\x7c\x62\x42\x82\x77\x8d\x85\x04\x8e\x5e\x5e\x0a\xfc\x43\x4e\x16\x58\xc2\x25\x2c\xab\xe9\x33\x4c\xc2\x0e\xd4\x4b\x98\x5c\x5c\x67\x62\xae\x36\x92\x8e\xa5\x6a\x14\x9b\x95\xa6\x8c\x4f\x80\xca\x5a\x6d\x45\x44\x49\xba\x4e\x6b\x01\x60\xc8\x3e\x86\x63\x38\x65\x74\x42\xa6\x75\xda\xad\x28\x2b\x4b\x8d\x15\x36\x50\x81\x83\x53\x90\x20\x3e\x2d\x03\xe0\xea\xcf\x51\x00\xae\x95\xc6\x82\xb3\x32\x1d\x7b\x1e\xa3\xd7\x98\xe2\x29\xf0\x35\x64\x45\x68\x35\xdf\x2d\x08\xf2\x63\x33\x3e\x03\x6a\xe5\x3b\xc3\x62\x36\x66\x98\x7b\x6b\xc8\x72\x38\x2b\xd3\xf9\x33\xb8\x9f\x00\xfb\x72\xf6\x63\x0d\x57\x01\x69\x1f\x01\xc0\x81\x9a\x54\xeb\x06\xc0\x80\x59\x79\xee\x88\xef\xaf\x65\xc9\x40\x56\x8e\x21\xf3\x06\x74\xc2\xf1\x29\xa3\x12\x13\xba\x96\xce\x8a\xb7\x32\xdf\x30\x0f\x46\x12\xcb\x50\xdc\x07\x1e\x96\xf0\x91\xc3\xf7\x10\xa8\x6b\x77\xdd\x35\x32\x56\x0d\xa7\x92\xfb\xd7\x53\xae\x84\xae\x19\x25\x92\xf1\x0b\x8e\x5d\x18\x02\x27\xcc\xab\xd1\x52\x2b\x57\xa7\x69\xc8\xbc\xf3\x05\x71\x25\x61\xf4\x8e\xcc\x81\x85\x72\xbd\x96\xb2\x4c\x9d\x86\x5b\x16\x4a\xb8\x05\x97\x51\x97\xf8\x04\x2b\xa9\x4d\x9b\x53\x29\x9a\x8b\x65\x2c\xf4\x86\x9c\x2d\x88\x07\xfc\x04\xbb\x8f\x6c\x32\xb1\xc4\xb3\x32\x68\x0d\xc7\x2d\x48\x4e\x40\x6c\x44\x15\x63\xd7\x30\x9e\x3f\x07\x8c\x02\x2d\x77\x70\x1d\x78\x0d\xe7\x59\xc8\x75\xb7\x6c\xc4\x99\x80\xd7\x70\xfe\x3b\x91\x12\xf8\x46\x8c\x11\xb4\x8a\xef\x16\x4b\xf0\xc9\x9c\xac\x69\x71\x0a\x5b\xcb\xf3\xd7\x70\xb4\x21\xd5\x5f\xc3\xd1\x5a\xb6\x93\xd0\x7d\x84\x4d\x6d\x8b\xc0\x06\x67\x28\x20\x0a\xfe\xde\xc0\x03\x2a\x89\x5c\x9e\x3f\x4b\xa0\x22\x1e\x8c\xd5\x0a\xdd\x97\x10\xe8\xe5\xc5\x10\x1f\x50\x21\x31\x75\xe1\x1a\x24\xf6\xb0\xc4\x99\x58\xb1\xc6\x90\xcb\xe6\xc8\x65\x38\x86\xb3\x9b\xd1\x9a\xe0\x66\xa0\xec\x0b\xca\xcd\xe8\x1a\x8b\xef\xeb\x96\x93\x0c\x65\xb0\x50\x90\x4f\x8c\x3f\x0e\x99\x4f\x2c\x21\x30\x57\x6b\x0e\x06\x25\x43\x3f\x9c\x12\x2a\xee\x6f\xaf\x1a\x47\x85\xce\x37\x2b\x0d\xa1\x05\x05\x79\x4a\xc9\x15\xa1\xe1\x73\xb5\xb4\x1d\x55\xa6\xf9\x9b\x50\x8f\x3d\x89\xb5\x44\x25\x5c\xc5\xaa\x40\x55\xe7\x84\xc0\xb1\x07\xa7\xc4\x2b\x4f\x9d\x1a\xac\xc1\x38\xc7\xcf\x43\xe6\x95\x23\x4e\xfc\x7b\xb1\x19\x36\x45\x49\x85\x81\x9d\xba\x9f\xc8\x74\x76\x37\xe3\x20\x66\xcc\xf7\x8a\x2d\x2d\x54\xe7\x04\xaf\xd8\x53\x8d\x9c\x59\x6b\x66\xda\x56\xb7\x8f\x28\x05\xf0\x05\x71\x61\xc8\x09\x75\x49\x80\xfd\x53\x9d\x66\x0e\xf4\x4a\x30\x17\x24\xd1\x6c\x87\x8d\xc0\xe5\xd1\x74\x8d\xa0\xab\x15\x02\x5f\xc0\x46\xe4\x39\xd3\xab\x80\x46\xdb\xd7\x59\xb0\x01\x5f\x04\x4e\x3b\x06\xa8\x97\x5a\x1a\x0a\xe0\x14\xcf\xcb\x59\xb3\xaf\x1c\xf7\xd8\x9b\x13\x7a\x1f\x43\x72\xee\xa1\x32\xa7\x8f\xdf\x3d\x3a\xe4\x30\x21\xcf\x5a\x5a\x32\x9f\x3d\x01\xdf\xc9\xfb\x8b\x02\x9e\x53\x2f\x60\x84\xca\xb3\x9b\xd1\x0d\x9e\x43\x24\xe3\xec\x6e\xb6\x25\x8a\x28\xe2\xac\x7b\x10\x94\x0c\x9d\x10\x2e\xe4\x29\xa3\x02\xdc\x50\x92\x85\x4e\x70\x88\x3b\x18\x96\xcc\xfd\x72\x3d\x22\x3f\xca\x0d\x35\x2b\x2d\xfb\x28\x21\x66\xc3\x70\xec\x13\xf7\x12\x96\x67\x71\x64\xcc\x77\xb9\x98\xdd\x8e\x8e\x53\x4c\x42\x41\x26\xa8\xfd\x09\x8b\x63\xac\xe2\xf7\x84\xf8\x90\x6e\xf1\xb0\x17\x6d\xdf\x8e\x83\xc0\xe2\x11\xf9\x6a\xa3\x11\x18\x7b\x77\x40\xb1\xd5\x8d\x8c\xba\x7c\x13\xb4\x1d\xe5\xce\xd5\xb6\xe8\xba\x0b\x90\xa7\x3e\x16\x82\xb8\xd7\xcc\x83\x7c\x9f\x9f\xb2\xd0\x92\x21\x18\x75\x89\x75\xab\x95\xf2\x7e\xbb\xf0\x6a\xd5\xbe\x8e\x47\x50\x77\x43\x5b\x57\xbc\xbc\xc4\x72\x59\x47\x47\x62\x9f\x27\x13\x61\xf1\x6b\xb3\xd2\x32\x48\x38\x20\x5f\x80\xab\xf5\xee\x0c\x26\x38\xf4\x35\x41\xaf\xd3\x3d\x68\x75\xf6\x5b\xfb\x9d\x46\xb3\x08\xbb\x22\xf4\x31\x0f\x7d\xdb\xea\x74\x5b\x9d\x6e\x02\xf5\x99\xab\xf3\x13\x15\x02\xbf\xea\x9f\xf4\xbf\xc6\x57\x0e\x82\x85\xdc\x85\x0b\xce\xc2\x60\x67\xb7\x9d\x00\x93\x71\x8a\x61\xb9\xa9\x14\x43\x94\xe1\x1a\xf3\x50\x50\xa2\x9b\xbb\xc0\x9c\xe0\xb1\x0f\x86\x80\x70\x76\xbf\xce\x99\xb7\x83\x3d\x6f\xa7\xd7\xf4\x81\x4e\xe5\x6c\xc7\xce\xbc\xbb\xdb\x54\xa8\xee\x3a\xd4\xee\x43\x61\x56\x1c\x2f\x30\xf1\xf1\x98\xf8\x44\x2e\x47\x71\xcf\xab\x24\x17\xcb\xa4\xd7\x5b\xd8\x80\x08\x90\x2d\xa7\x89\x0c\x63\x55\x68\x18\x85\x93\x6c\x46\x47\xeb\x71\xfa\x6b\x79\x31\x36\x04\x52\x3c\xe3\xee\x0c\x84\xe4\x58\x32\x7e\x63\x8b\x47\x45\x80\xb9\x3a\x00\x05\xfd\xfb\x29\xf3\xca\x82\xb9\x5a\x43\x6a\xf2\xdd\xa3\x49\x60\x4a\x2c\x2d\x44\x96\x12\xc2\x34\x58\x0c\xe6\x78\x0a\x9f\x27\x13\x4b\x7e\x6a\x56\x6a\x19\x94\x13\xd2\xc1\x42\xcc\xaa\x05\x53\x80\x45\x78\x74\x79\x5f\x25\x36\xba\xbc\xb7\x08\xc4\x2e\x5f\x25\x14\x57\x1b\x4d\xcb\xb9\xb8\x16\x2b\x3a\xbd\x1a\xc2\x34\xcc\x55\x84\x17\x45\xa4\xf6\x4c\x77\xca\x4f\xd2\x21\x8d\x5d\xcb\xf0\x9f\x24\xfe\x9a\x2b\x43\xd3\xd1\xa2\x52\x41\xd2\xe9\x6e\x84\x98\x8d\x88\xf1\x14\xa8\xcc\xb1\x22\x1b\x6d\x16\x45\x32\xd6\xc1\x59\xae\xd9\x03\x6f\xc7\xb9\x26\x2e\x67\x82\x4d\x64\xfb\x26\x4a\x26\xf7\x32\xb8\xc8\xcf\x88\xbc\x75\xe6\xac\x10\x62\x76\x83\xe5\x90\x71\xa9\xe3\x4a\xaf\xd7\xec\xf5\x3a\x5d\x55\xe8\xff\xed\xab\xa2\xff\x90\x81\x2f\x61\x39\xc4\x72\x96\x9b\x94\x7b\x33\x36\x87\x3d\xa7\x69\x28\x0c\xd3\x15\xba\xe9\xec\xb5\x85\x98\xed\xe1\x50\xce\x18\x27\x3f\xc0\xfb\xcf\x47\x58\xc6\xf9\x5a\xb6\x2a\x8d\x24\xe3\x78\x0a\xc7\xae\xab\x82\xf1\x19\x11\x8f\xa2\x1c\x4a\x63\x50\x16\x1f\x0f\x5a\xdd\xb7\x46\x82\x18\x1d\x91\xe6\xa9\x1a\x47\xa8\xd7\xc9\x20\xf9\x4a\x05\x3f\x9e\x26\xbb\x4e\x8f\x2c\xf2\x6e\x60\x9c\xb9\x3a\xbb\x4d\x5b\x55\x9e\xce\xec\x58\xb5\x33\xc9\xd7\x46\x83\x3e\x02\x50\xab\xe5\xfb\x77\x49\x9f\x5a\x30\x7a\x63\xfd\x15\x35\x3a\x8d\x26\x6a\x1c\xa8\xc2\x55\x05\x51\x05\x53\x45\xa8\x8a\xae\x2a\xde\xa9\xc2\x53\xc5\x7f\xa9\x22\x50\xc5\x42\x15\x3d\x55\x1c\xaa\x02\x54\xf1\xa8\x8a\xef\xaa\x78\x52\xc5\xbe\x2a\xde\xab\x62\xa2\x0a\x5f\x15\x5c\x15\xcf\xaa\xe8\xab\x02\xab\x62\xaa\x8a\xb9\x2a\x84\x2a\x96\xaa\x78\xab\x8a\xb1\x2a\x66\xaa\xa0\xaa\x90\xaa\xf8\xd1\x48\xd7\x11\x7b\xab\xb2\xe5\x3b\x5e\x13\x8c\x2e\xb5\x4b\x98\x3d\xba\x98\xd7\x8f\x6e\x9e\xe1\x04\x8b\x6c\x2a\x86\x94\x7c\x0f\x61\x24\x39\xa1\xd3\x9d\xaa\x09\x9f\x25\x8f\xf9\xc1\x36\x17\xab\xc4\x98\xd5\xea\x02\xa4\xca\xcf\xae\x71\xa0\x32\x87\x7c\x30\xa8\x1e\x53\x7b\xff\x98\xb6\x16\x53\x25\x35\x39\xe2\x1d\x43\xfd\xac\x30\x41\x59\xb2\xd1\x6f\xed\x77\x5a\x01\x87\x05\x81\xa7\x6d\xb2\xb0\x42\x8a\x34\x28\x4c\xd0\x7c\x8a\x94\xaf\xab\x09\x80\xf6\x66\xeb\x38\x38\x17\x92\x77\x8c\xf4\x2d\x36\xd3\x08\x86\x01\x67\x0b\xa2\x1b\xec\x72\x12\xc8\xe8\x40\xe0\x02\xe4\x65\xba\x7f\x3c\x39\xe8\x0f\x13\x50\x76\x28\x30\x57\xba\x40\xba\x5e\x9d\xdc\x75\x02\xca\xe4\xe2\x65\x1a\x86\x9c\x3d\x2f\x4f\x81\x4b\x51\x47\x70\x51\x42\x67\x4c\xc5\x4c\x21\x1e\xb8\x3b\x3c\x8d\xb8\xda\x9f\x0d\x40\xd2\xe5\xe6\x6f\x77\xcb\x00\x5e\x5e\x8e\x36\x40\xc6\xd4\x5a\xb7\x1e\xc8\x81\xf8\x72\x73\x7e\x37\xa0\x12\xa6\xca\xbc\x2c\x41\xf5\xb5\x5f\xc3\x0d\x8b\xb6\xda\xca\x67\x26\xd8\x17\x50\x74\x66\x1b\x50\xf2\x10\x7e\xc6\x99\x4e\x43\x21\xd9\x5c\x19\x96\x68\x51\x7b\xf3\x51\x38\xa6\x20\xe3\x95\xce\xb2\x21\x32\x20\xe6\x6e\x54\xff\xa4\xba\xf5\x36\x5e\x1c\x47\x30\x9d\xab\x3d\x2b\xf5\xe0\x59\xdf\x95\x95\x90\x5a\x83\x08\x7c\x22\x2d\x7b\xc3\xbc\x9e\x26\x72\xf6\x9c\x5d\x33\x17\xae\x57\xe8\x18\xf9\xec\xa2\xde\xb0\xc3\x04\x46\xb8\x0c\xb1\x1f\xaf\xe2\x3f\x6d\x5f\x9d\xd6\xa2\x75\xb7\x66\x1a\x55\x63\x6a\xdf\x6a\x6a\x49\xfa\xa7\xed\xde\xc8\x9e\xb4\x11\x85\xa8\xab\xb9\x2b\x9c\x27\x52\x6c\x75\x9b\x8a\x58\x55\x4e\xef\x9b\xc8\x69\x89\x22\xcf\x22\x73\xd9\xfa\xe4\x2c\xdf\x75\x22\x97\x2e\x95\x3d\x20\xb7\xf0\x15\xe7\x46\xd9\xd8\x45\xd2\xab\xce\x5e\x64\xa1\xc8\xe7\x63\x59\x6b\x73\xc4\x76\xc7\xdb\xb4\x2f\x94\xd2\x8d\xb6\x5e\x1b\x78\x8d\xe3\x14\x03\xff\xff\xfc\xc8\xfe\xae\xde\xf9\x7f\x0f\xfa\x65\x1e\x94\x4b\x18\xb6\x39\x08\x7e\x8c\x6f\x02\xa2\xd3\xca\xc1\xd0\x7a\x4a\x6d\x02\x0a\xb2\xf1\xef\x95\x07\xdc\x46\x7d\xf1\xa2\xdf\x0f\xf5\xe9\x54\x95\xa4\x51\x6f\x48\x7a\xcc\x7d\x04\x7e\xc2\x89\x37\xb5\x2b\x2d\x02\x8c\x53\xc0\x81\xc8\x32\x97\x38\x5f\xb8\x00\xd4\xe8\xb6\x0f\xda\x9d\x46\xba\xf5\x84\x29\x51\x7a\xff\x26\x72\x76\x87\x09\xd5\xfb\xc3\x06\x65\x1e\xb4\x38\xf3\xa1\x9d\x9d\xda\xb7\x09\xdb\x8b\xa6\xe2\x07\x95\x17\x1c\xdd\xb0\x91\x3b\x03\x2f\xf4\xa1\xb8\x4b\xd6\xda\x3f\x61\xa1\xaf\x20\xf4\xbe\x4b\x14\xd5\xc5\xa2\xca\x19\x94\x3e\x9d\x91\xa4\x39\x77\x6e\xc3\x6d\x17\x50\x16\x64\xf8\x5c\x12\x59\x91\xa6\xa4\xc7\xba\x54\x4c\xb7\x3d\x14\x40\x0e\x15\x53\xdb\x71\x40\x3d\x97\xed\x1c\xc0\x24\xca\x5c\x98\x8a\xe9\x46\xa1\x21\xbe\x4e\x1a\x81\x1b\x72\x22\x97\x7a\x62\xe4\x03\x44\x6c\x91\x39\xa9\x02\x4e\xe6\x98\x2f\x0b\xe7\x6d\x45\xbb\x9d\xd5\x0a\xed\x10\xb5\xee\xa2\xb6\x1e\x33\xb5\x1d\x8e\x53\x39\x81\x3a\xbb\x6d\x25\x80\x5e\x5e\x72\x87\x72\x23\x3d\xaf\xeb\xa6\xf5\xba\xd1\x88\x0f\xd9\xf5\x21\xf6\x60\x78\xec\x79\x1c\x84\xd8\x3a\xc0\xc4\xe7\x85\x24\x28\x44\x19\xcb\x8e\x0f\x39\x1b\x45\xa2\x48\xf2\x6a\xbc\xd1\xb0\xf8\x0c\x7b\x27\xd8\xc7\xd4\x05\x9e\x1f\x8e\x84\xa6\x38\x26\x29\xfd\x30\x7a\xdb\x53\x19\xaf\x33\x3b\x74\xcc\x9e\x70\x46\x25\x50\x2f\x91\x8b\x2f\xb3\x55\x10\x2f\xb7\x3b\xa3\x5f\xa7\xfe\xb5\x1d\xee\x8f\x3f\x2a\x83\xce\xa9\xb7\x55\xa7\xbe\x5e\xdd\x7a\x35\xda\xe1\xa6\xb2\xb8\x19\xd1\x67\x12\xa8\x9b\x77\x3b\xb5\x5d\xe2\x14\xfb\xaf\xb7\x88\xc4\x0c\x1b\x99\x66\xd1\xfb\x4b\xdc\x2b\xdf\x8c\x5a\x75\x3f\x39\xde\x46\x73\x5f\x31\xf0\x65\x3b\xd6\xb8\xbd\xd9\x4f\xdb\xbb\xbf\xbd\xd9\xf5\x66\xa5\xb7\x40\x7a\xe7\x1f\x5f\xd4\x64\x80\xe4\x8a\x2f\x82\x45\x77\x49\xf9\xa7\x6e\xc3\x41\x74\x89\x16\xa7\x16\x95\x87\x50\x84\x0b\xa9\xa2\x5d\x16\x97\xb0\xe7\xd5\xf6\xc0\x30\xb9\x84\x6a\x22\x42\xeb\x28\x3f\xbb\x12\x64\x3f\xba\x73\xb1\xec\x9f\xaa\x8d\xdd\xe6\x72\xd3\x72\x83\x76\x35\x3e\xc1\xee\x23\x50\x4f\xad\x1c\xaf\xf5\xb0\x80\x31\x7f\x0b\x97\x4a\x1b\x7d\xca\xe6\xf3\xf8\x80\x57\xce\x40\x00\xba\xb6\xd6\x23\xcc\x01\x85\x02\x3c\x24\x19\x0a\x7c\xec\x02\x9a\x87\xbe\x24\x81\x0f\x28\x62\x14\xc8\xcd\x5a\xed\x2f\x11\xa1\x48\xce\x40\x8d\x8e\x5a\x99\x90\x08\xb0\x0b\x15\x36\xe8\x8e\x17\x15\x9b\xe3\xea\x0e\x6d\x3a\xed\xf2\x0d\x77\x7e\x30\x8b\xf7\x74\x56\xc5\xce\xee\xd7\xfd\xe2\x25\x5b\xc1\xcf\x36\xf3\xc9\x94\xae\xf3\xa0\x6c\x2b\x07\x9c\x32\xb2\xbb\x31\xb2\xf7\x60\x6b\xaf\x99\x70\xbd\xc6\x6d\xaa\x3d\x46\x2f\xf8\x76\x75\xe6\x15\xeb\x16\xb9\x60\x27\xa3\xdb\x4a\xae\xfb\x4a\xb9\xde\x2b\xe5\xf6\x5f\x29\xd7\x2f\x5d\x17\x17\x5e\x42\xa8\xf1\xdc\xac\xef\x2a\xc2\x5c\x67\xeb\x10\xf6\x2a\x35\xdd\xdf\xa3\xa6\xf7\x7b\xd4\xec\xff\x1e\x35\xfd\xad\xd4\x58\xdc\xe4\x5c\xba\xf1\x43\x92\x21\xe3\xfa\xe6\x66\xff\xb0\x53\x42\x44\xef\x83\x52\xc4\xbb\xf7\x25\xc4\x10\x80\xdf\xdf\x5e\x89\xc6\x51\xc9\xcf\x9c\x99\x94\xc1\xd1\x9e\x75\xd5\xcf\x7b\x69\x14\xc4\x90\x73\x64\x83\xe6\x2d\x75\xac\xdd\xb6\x95\xaa\xee\xef\x53\xd5\xfb\x7d\xaa\xf6\x7f\x9f\xaa\xfe\x36\xaa\x2a\x7c\x2f\xf2\xac\x7f\xde\x73\x32\x0f\xfe\xc7\x3d\xe7\x97\xaa\xaa\xf7\x9c\x5f\xaa\xaa\xde\x73\x7e\xa9\xaa\x7a\xcf\x29\xa8\xaa\xf4\x9c\x30\xba\xe6\xc4\x72\xab\xdc\x20\xf5\x95\x0f\x55\xfa\x93\x58\xa6\x81\x5b\xac\xca\x5b\x32\x37\x91\xd3\xb4\x01\x33\xb2\xee\xa6\x64\xdd\x0d\xc8\x7a\x9b\x92\xf5\xfe\x4f\xb6\x79\x3d\xd9\xfe\xa6\x64\xfb\x1b\x90\xf5\x37\x25\xeb\x3f\x18\x53\xe0\x35\x7b\x43\xe3\xab\xa8\xf8\x91\x9a\xf9\x5a\x34\x7f\xe8\xb9\x55\x32\xaf\x65\xd7\xec\x00\xb3\x7c\x3e\xff\x5a\x36\x1c\x0b\x7d\x6d\x4f\x18\x8d\xdf\xa9\x9a\x3f\xed\xec\xb6\xf3\x88\xec\x25\x3e\xa3\x92\x93\x71\x28\x19\xbf\x65\x3e\x9c\xc1\x84\x50\x62\xb0\xa4\xaf\x9f\x4c\x79\x7d\xe0\x50\xcb\xdf\x44\xce\x5e\x10\x7f\x3f\x21\xf6\xb2\x33\x9d\xe3\xf8\x7d\x94\x3e\xb7\xd8\xe3\x39\x8d\x9a\xd5\x19\xf7\xfa\xef\x0f\x0f\xb1\xdb\x3a\xe8\x1e\x76\x5a\xfd\x1e\xee\xb4\xf0\xf8\xf0\xb0\xd5\xeb\x4c\xde\xed\x1f\xf6\x3c\xaf\xd7\x77\x73\x6f\xe6\xb0\x07\xff\x4b\x4c\xc7\xae\xe7\xbd\xeb\xe1\x77\xad\xfd\xfd\xc3\xb7\xad\xfe\x21\x4c\x5a\x63\xaf\xdf\x6b\x4d\x0e\x3a\x07\x93\x31\x3e\xec\x62\x78\x67\xde\xe0\xb9\x2c\x00\xeb\x33\x3f\x92\x8d\x8f\x34\x9f\x1e\x17\xec\x4e\xea\x32\x30\xe6\x53\x90\xe7\x74\x41\x38\xa3\x73\xdb\x67\x46\x25\x44\xe9\xb2\xe5\x9c\x4e\x09\x85\x33\xf6\x44\x7d\x86\xbd\x5b\x08\x58\xc5\xa5\x4b\x19\x58\xc1\x65\xbc\x83\xec\xb6\xbb\xbd\xf6\xbf\x35\xe2\x37\x3f\xd1\x37\x1a\xf1\x09\xe5\x27\x2c\xa2\xe7\xf2\xc9\x65\x49\x13\x35\x7c\x03\x10\x57\x36\xd0\x51\x1c\x48\x8d\xd7\xc1\x68\xb5\xe2\x98\x4e\x01\xa1\x37\x0b\x7d\x77\xde\x44\x6f\x16\x38\xf4\x25\x3a\xfa\x50\x50\x93\xd7\x91\xc9\x93\x49\x22\xfb\xf2\x82\x9a\xb9\xf3\x1d\x03\x56\xf8\x5b\x0d\xa2\x1e\xb8\x2f\xd1\x83\xe6\x72\x3d\x42\x0d\x52\xfa\x6e\x42\x37\xeb\x12\x96\x5a\x6a\x70\xb6\x5a\xa5\x9a\xd3\xad\xa7\xf9\xef\xa5\x59\x56\xab\x5b\x67\x7c\x56\x6b\x24\x7b\xe5\x5e\x79\xe3\x26\x9d\xe2\x02\xd7\x7d\x12\xf5\x4e\xfb\x4b\x91\xa5\xd4\xe2\xac\x73\xdc\x75\x9d\x63\xef\x20\x6d\xad\x9b\xa9\xb8\xe7\x7e\x03\x6d\xdc\x1f\x86\x6d\xf7\xb7\x57\xab\x55\x6a\x85\xb5\xa3\x10\x2a\xdb\x54\x65\xeb\xc3\x1f\x55\x92\x79\x89\x87\xf2\x3b\xb5\xf8\x8b\xa0\xc4\x4d\x1b\x4f\xd1\xdf\xb9\x6f\x36\x4a\x73\xc6\x06\x32\xe6\x8b\x59\x3d\xc4\x42\x3c\x31\x5e\xfe\xda\xc0\x06\x2a\x5c\xb3\x9e\x10\x8a\x39\x01\x31\x3a\x1e\xe9\x4f\x9a\x2c\x4b\x57\x1e\x52\x21\x5f\xf5\x76\xd9\x82\x29\xb7\xe2\x0e\x7c\x98\x83\xe4\xcb\x8b\x7b\xcb\x33\x27\x1b\xa8\xb8\x80\x26\x1f\x5d\x99\xef\xb5\xd3\x48\x1c\x57\x46\xeb\xb3\x4d\x2c\x7d\x1b\xbe\x16\x39\x7a\x0c\xd3\x47\x84\x67\x58\x62\x17\xa8\x04\xde\x7a\x22\x72\xd6\x4a\x3f\x05\x16\x36\xc9\xaa\x0e\xb2\x60\xcc\x90\x4f\xe8\xd4\x87\xbf\x42\x16\x7d\x2e\xef\x14\x3a\x2e\x7a\x30\x16\xbd\xbf\xcb\x1e\xf1\xa3\x37\x84\x06\xa1\xfc\x48\x7c\x40\x1f\x90\xf3\xaf\xd1\x7f\x8c\xee\xce\xaf\xcf\x6e\x07\x5f\xce\xff\xf5\xed\xdb\xf1\x8f\x90\x83\xb2\xfd\xdb\xb7\x48\x5c\xfd\xbf\x3d\x26\xd4\x41\x7f\xa2\x37\x2c\x94\x5b\x8a\x8e\x40\x86\x41\x64\x42\x3b\x10\x5d\xc5\x72\xca\x82\x65\x6b\x20\x61\x6e\x5a\x62\x52\xff\x89\x06\x74\xc1\x1e\xa1\x75\xfe\x1c\x70\x10\xaa\xd1\x3b\xce\xaa\xf3\x82\x56\xdd\x17\x07\xb5\x26\x26\xb8\x89\xde\x60\x3e\x0d\xd5\xda\x23\x76\xd1\x9f\xa8\x91\x4c\xb0\xff\x0e\x00\x00\xff\xff\x1a\x16\x74\x75\x02\x42\x00\x00")
+var _kubernetesmastervarsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\xeb\x6f\x1b\xb9\x11\xff\xde\xbf\x62\x21\xe4\x20\xbb\xb0\x64\x49\x56\x6c\xc7\x87\x7c\x70\x6c\x27\x51\x13\xfb\x74\x96\xed\x43\x9b\x18\x05\xb5\x4b\x49\xac\x57\xcb\x0d\x97\x2b\x5b\x31\xfc\xbf\x77\xc8\x7d\x91\xbb\xdc\x87\x9c\x9c\x5b\xa0\x3d\x14\x84\x23\xfe\xe6\xc1\xe1\x70\x66\xf8\xd8\x5a\x96\x65\xb5\x96\xe8\xe1\xe6\x3c\x18\x63\x36\xa6\xd4\x6d\x1d\x59\xfd\x5e\x6f\xe7\x2f\x96\xe8\x41\x3e\x99\x60\xb6\xc2\xec\x04\x33\x4e\x66\xc4\x46\x1c\x03\xa0\xf5\xc5\x47\x0c\x2d\x31\xc7\x2c\xd8\x6a\x9b\x40\xed\xed\xdb\xd6\xce\x5f\x1e\x1f\x2d\x32\xb3\x3c\xca\xad\x51\xf0\x91\x06\x1c\x3b\xe7\x08\x5a\x66\x3d\x3d\xe5\xf8\x8f\x19\x59\x01\xd9\x27\xbc\x2e\x67\x9f\x61\x12\xee\xd8\x73\x12\x4e\x36\xaa\x52\x51\xeb\x8d\xa8\x63\xaa\x0a\xc1\x6a\xa7\x4a\xe3\x12\xec\xf1\x4a\x69\x79\x44\x81\xba\x4a\x6a\x0e\xa0\xd0\xde\x85\x53\x7c\x42\xbd\x19\x99\x57\x49\x37\xa2\x8c\x5c\x2a\xb4\x30\x81\x72\x3c\x98\x07\xd8\xe0\xe3\xda\xc7\x4c\xfc\x73\xe2\x63\xdb\xc8\xc6\x80\x33\x72\x3a\x76\x1c\xea\x9d\x23\x0f\xcd\x31\xab\x61\x96\x87\x96\xf3\xbb\xc4\x01\xf9\xde\x8c\x9f\x02\x35\xf2\x3b\x45\xc1\x62\x4a\x11\x73\x6a\x98\x69\x38\x23\xa7\xb3\x07\x6c\x7f\xc4\xc8\xe5\x8b\xef\x35\xbc\x72\x48\xf3\x0c\x60\xe4\x8b\x45\x55\x37\x01\x0a\xcc\xc8\xe7\x8a\xb8\x6e\x2d\x97\x0c\x64\xe4\x31\xa6\xce\xc8\x9b\x31\x04\xae\xc3\x11\xf1\x6a\xd9\x19\xf1\x46\xce\x17\xd4\xc1\x13\x8e\x78\x18\x5c\xfb\x0e\xf8\xe3\x7b\x86\xbf\x85\xd8\xb3\xcd\xae\x5b\x43\x63\x94\x70\xc2\x99\x7b\x3e\x67\x82\xe8\x9c\x7a\x84\x53\xf6\x81\x21\x1b\x43\x58\x24\xd4\xa9\x90\x52\x49\x57\x25\x09\x06\x7f\xb6\x22\x36\x27\xd4\xbb\x22\x4b\x4c\x43\x5e\x2f\xa5\x48\x53\x25\xe1\x12\xfa\xf1\x25\xb6\xa9\x67\x13\x97\x20\x41\xd5\x74\x38\xa5\xa4\x5a\x2c\xa3\xa1\x33\x66\x74\x45\x1c\xcc\xde\x21\xfb\x8e\xce\x66\x86\x78\x56\x04\xd5\xf0\xb8\xc4\x9c\x11\x1c\x34\x62\x15\x63\x6b\x38\x9e\x3d\xf8\xd4\x83\xb0\xda\x88\x65\x02\xae\xe1\x79\x1a\x32\x69\x96\x46\x3c\x13\x70\x0d\xcf\xbf\x11\x0e\x3c\x1a\x71\x8c\xa0\x65\xfc\x2e\xc1\xdd\x5d\xb2\x24\x35\x23\x4e\x61\xb5\x7c\x7e\x1f\x4f\x1a\xb2\x02\x64\x2d\xb7\x77\xa1\x7d\x87\x9b\xea\x16\x81\x15\x9e\x61\x80\xa3\xe0\xef\x8c\x1c\x98\x27\xc2\xd7\x67\x0f\x1c\x7b\x41\x3c\x19\x50\x75\x5c\x17\x10\x50\x72\x28\xe4\x23\x2f\xe0\xc8\xb3\xf1\x39\xe6\x08\x22\x03\xca\xc8\xf2\x3d\x0a\x5d\xb6\x46\x3e\xc1\x5f\xa7\x17\x93\x9a\xe0\xa6\xa0\xcc\x09\xe5\x62\x02\xe5\xd0\xb7\xba\x74\x92\xa1\x14\x2e\xd0\x75\x4f\xd9\xdd\x98\xba\xc4\x10\x02\xb5\x5e\x75\x32\x3c\x32\x76\xc3\x39\xf1\x82\xeb\xcb\xcf\xad\xa3\x9c\xf1\xd5\x4e\x85\x68\x05\xdc\x4e\x3c\xf2\x99\x78\xe1\x43\x39\xb5\x19\x55\x64\xf3\x07\xf1\x1c\x7a\x1f\xd4\x32\x2a\xe0\x4a\xb2\x82\x27\x8c\x13\x62\x86\x1c\x7c\x42\x9c\xe2\xd2\xa9\xc0\x2a\x1c\xa1\x02\x86\xc8\x5a\x8c\x38\xf1\xef\xf9\x61\x98\x04\x25\x1d\x0a\x76\x6e\x7f\x24\xf3\xc5\xd5\x82\xe1\x60\x41\x5d\x27\x3f\xd2\x5c\xb7\x46\xf8\x99\xde\x57\xd0\xa9\xbd\x6a\xa5\x6d\x74\xfb\x88\x65\x00\x25\x34\x81\xac\xc4\x08\x04\x74\x1f\xb9\x27\xb2\xcc\x1c\xc9\x4c\xb0\x0c\x48\x22\xd9\x0c\x9b\x60\x9b\x45\xcb\x35\x82\x82\x34\xec\x06\xb8\x11\x73\x4d\xf5\x32\xa0\x32\xf6\x3a\x0d\x1a\xf0\x8b\xc0\xa9\x61\x60\x97\x90\x6a\x0a\x6b\x9f\x79\x40\x5e\xe0\xe4\x0a\xc7\x3d\x76\x96\xc4\xbb\x8e\x21\x9a\x7b\x88\xca\xe9\xfd\x37\xc7\x1b\x33\x3c\x23\x0f\x92\x9a\x53\x97\xde\x63\xb6\xa5\xfb\x8b\x00\x9e\x79\x8e\x4f\x89\xc7\x61\xe9\x5e\x40\x57\x44\xd3\xde\x6e\xb6\x25\x8a\x58\xc4\x55\xf7\xc8\x2f\x28\x3a\x23\x2c\xe0\x50\x2f\x05\xd8\x0e\x39\x59\xc9\x02\x87\xd8\xa3\x71\x41\xdd\x9b\xf3\x09\xd4\xb3\x06\x97\xce\x3a\x0d\xfb\xa8\x20\x58\x8c\xc3\x29\xc4\x0d\x28\xf8\x4f\xe3\xc8\xa8\x9b\x3c\x58\x5c\x4e\x8e\x53\x4c\xc2\x02\x06\xd5\xfd\x88\x82\x63\x24\xe2\xf7\x8c\xb8\x38\xdd\xe2\x21\x27\xda\xbe\x1d\xfb\xbe\xc1\x23\xf4\x6e\x65\x10\xd0\x71\x85\x3d\x64\x74\x23\xa5\x4f\x1f\x82\xd4\xa3\x68\x5c\xa9\x8b\xec\xfb\x00\x8b\xd4\x45\x41\x40\xec\x73\xa8\xd7\x74\x9b\x9f\xd0\xd0\x50\x21\x28\x7d\x89\x76\x20\x0d\xbc\xdf\x4c\xfc\xf8\xd8\x3d\x8f\x67\x50\x9a\xa1\x2b\x3b\x9e\x9e\x62\xba\xcc\xd0\x11\xd9\x6f\xb3\x59\x60\xf0\x6b\xb5\xd3\x30\x49\xb0\x25\xbe\x01\x1c\xe4\xbb\x53\x3c\x43\xa1\x2b\x19\x0c\x7a\xfd\xfd\x4e\x6f\xaf\xb3\xd7\x4b\x4d\x98\xc2\x20\x2c\xdf\xe9\xd0\xd7\x9d\x5e\x1f\xfe\x97\x40\x5d\x6a\xcb\xfa\x44\x84\xc0\x2f\xf2\x27\xf9\x5f\xeb\x0b\x44\x19\x1a\x32\x1b\x7f\x60\x34\xf4\xb7\xb6\xbb\x09\x30\x99\xa7\x18\xa6\x2d\xa5\x18\x22\x14\x97\x98\xdb\x9c\x10\x39\xdc\x15\x62\x04\x4d\x5d\xac\x10\x40\x9c\xfd\xb2\xa4\xce\x16\x72\x9c\xad\xc1\x8e\x8b\xbd\x39\x5f\x6c\x99\x39\x6f\x6f\xef\x08\x54\xbf\x0e\xb5\x7d\x9b\x5b\x15\xc7\x2b\x44\x5c\x34\x85\x9a\x96\xaf\x27\xb1\xe5\x45\x91\x8b\x78\x62\xf5\x0e\x52\x20\x60\xff\x4e\x7b\xc7\x52\x94\x15\xa1\x61\x12\xce\xb2\x15\x1d\xe5\xe3\xf4\xd7\x62\x32\x56\x08\x52\x3c\x65\xf6\x02\x07\x1c\x8a\x42\xca\x2e\x4c\xf1\x28\x0f\x50\xb3\x03\x86\x8d\x92\xf8\xfd\x04\x5c\xb8\x40\xa8\xf5\x2a\x54\x33\x88\x5e\x49\x60\x4a\x34\xcd\x45\x96\x02\x42\x55\x38\x18\x2d\x21\xb1\x80\x4b\x1a\xea\x53\xb5\x53\xd2\x58\x1a\x91\x0c\x16\xc1\xa2\x9c\x30\x05\x18\x88\x27\x9f\xae\xcb\xc8\xa0\xcb\x40\x10\xbb\x7c\x19\x51\xdc\xad\x0c\x4d\x73\x71\x49\x96\x77\x7a\x31\x85\x69\x98\x2b\x09\x2f\x82\x91\xd8\x33\x5d\x09\x3f\x49\xa7\x34\x76\x2d\xc5\x7f\x92\xf8\xab\x66\x86\x9d\xb6\x24\xe5\x02\x92\x2e\x77\x25\xc4\x34\x62\x0c\x63\xf3\xb8\xc6\xd5\x32\xb1\xcd\xa2\x48\xc6\x75\x74\xaa\x0d\x7b\xe4\x6c\xb5\xcf\x89\xcd\x68\x40\x67\xbc\x7b\x11\x15\x93\xbb\x19\x3c\xd0\x57\x84\xae\x9d\xba\x2a\x20\x57\x5c\x20\x3e\xa6\x8c\xcb\xb8\x32\x18\xec\x0c\x20\xf4\x88\x46\xfe\xb5\x27\x9a\xe1\x6d\x06\x86\x7c\x32\x46\x7c\xa1\x2d\xca\xdd\x05\x5d\xe2\xdd\xf6\x8e\x22\x30\x4c\x33\xf4\x4e\x7b\xb7\x0b\x74\xbb\x28\xe4\x0b\xca\x20\xa3\x39\xff\xbc\xc3\xeb\xb8\x5e\xcb\xb2\xd2\x04\x96\x03\x98\xe7\xd8\xb6\x45\x30\x3e\x25\xc1\x5d\x50\x0c\xa5\x31\x28\x8b\x8f\xfb\x9d\xfe\x6b\xa5\x40\x8c\x8e\x48\x75\x56\x00\x1e\xf4\x32\x88\xde\x29\xe0\xc7\xf3\x64\xd7\xe9\x90\x95\xee\x06\xca\x99\x2b\x0c\xc4\xd4\xa5\xb3\x53\x0d\x2b\x76\x26\x7a\x6f\x34\xe9\x13\x8c\x45\xb6\x7c\x73\x90\xd8\xd4\x80\x91\x1b\xeb\x2f\x56\x0b\xd2\x84\xd5\xda\x17\x8d\x2d\x1a\x22\x1a\x2a\x9a\x50\x34\x7d\xd1\x1c\x88\xc6\x11\xcd\xbf\x44\xe3\x8b\x66\x25\x9a\x81\x68\x0e\x45\x83\x45\x73\x27\x9a\x6f\xa2\xb9\x17\xcd\x9e\x68\xde\x88\x66\x26\x1a\x57\x34\x4c\x34\x0f\xa2\x19\x8a\x06\x89\x66\x2e\x9a\xa5\x68\x02\xd1\xac\x45\xf3\x5a\x34\x53\xd1\x2c\x44\xe3\x89\x86\x8b\xe6\x7b\x2b\xcd\x23\xe6\x51\x65\xe9\x3b\xce\x09\x8a\x49\xcd\x14\xaa\x45\x57\xcb\xea\xd9\xd5\x39\xbc\x43\x41\xb6\x14\x43\x8f\xc0\xae\x62\xc2\xa1\x08\x9d\x6f\x95\x2d\xf8\xac\x78\xd4\x27\x5b\x4d\x56\x89\x32\x8f\x8f\x50\xa6\x88\xfa\xec\x1c\xf9\xa2\x72\xd0\x83\x41\xf9\x9c\x9a\xed\xa3\xea\x9a\x2f\x95\xc4\xe2\x88\x77\x0c\xd5\xab\x42\x05\x65\xc5\xc6\x10\x8a\x8d\x8e\xcf\xf0\x8a\xe0\xfb\x4d\xaa\xb0\x5c\x89\x34\xca\x2d\x50\xbd\x44\xd2\xfb\x2a\x02\xa0\x79\xd8\x32\x0e\x2e\x21\x93\xf6\x94\xf2\x2d\x56\x53\x09\x86\xbe\x38\x75\x90\x03\xb6\x19\xf1\x79\x74\x20\x00\xd3\xf0\x29\xdd\x3f\xbe\xdb\x1f\x8e\x13\x50\x76\x28\xb0\x14\xb2\x30\xb7\x9d\x2a\xba\xf3\x04\x94\xd1\xc5\x69\x1a\x82\x35\x7d\x58\x8b\x83\xfa\xa0\x8a\xc1\x87\x02\x3a\xe3\x94\xaf\x14\xe2\x89\xbb\x42\xf3\x88\x57\xf7\x37\x05\x90\x98\x5c\xfd\xed\x6a\xed\x83\x73\x1d\x35\x40\xc6\xac\xa5\x6c\x39\x91\xa3\xe0\xe6\xe2\xec\x6a\x04\x83\x9b\x0b\xf5\xb2\x02\xd5\x95\x7e\x8d\xc5\xa1\xa8\xd8\x14\x0b\x9f\x99\x21\x70\xe2\xbc\x33\x9b\x80\x9c\x85\xf8\x47\x9c\xe9\x24\x04\x4f\x58\x0a\xc5\x12\x29\x62\x6f\x3e\x09\xa7\xd0\xc6\x99\xce\xb0\x21\x52\x20\xea\x6e\x54\xfe\x24\xcc\x7a\x19\x27\xc7\x09\x9e\x2f\xc5\x9e\xd5\x73\xf0\x83\xbc\x2b\x2b\x20\xa5\x84\xc0\x87\xf2\xd1\xb0\x37\xd4\xe5\x80\x6b\xee\x42\xd1\xab\x3a\x71\xa5\xc0\xb6\x52\xcf\xae\xaa\x15\x3b\x4c\x60\x84\xf1\x10\xb9\x71\x16\xff\x61\xfd\xaa\xa4\xe6\xb5\xbb\x54\xcb\xa8\x0a\x55\x87\x46\x55\x0b\xd4\x3f\xac\x77\x23\x7d\xd2\x41\xe4\xa2\xae\xe4\x5d\xe2\x3c\x91\x60\xa3\xdb\x94\xc4\xaa\x62\x79\x0f\x2a\x77\x82\x3c\x9f\x55\xe6\xb2\xd5\xc5\x99\x6e\xba\x40\x2b\x97\x8a\x1e\xa0\x25\xbe\xfc\xda\x28\x2a\xbb\x4a\xac\xda\xde\x8d\x34\x0c\xf4\x7a\x2c\x1b\xad\xc6\xd8\xec\x78\x4d\x6d\x21\x84\x36\xda\x7a\x35\xf0\x9a\x76\x3b\x1f\xf8\xff\xf3\x33\xfb\x52\xd6\xf9\xbf\x07\xfd\x34\x0f\xd2\x0a\x86\x4d\x0e\x82\xef\xe2\x9b\x80\xe8\xb4\x72\x34\x36\x9e\x52\xab\x80\x1c\x6d\xfc\x7b\xe9\x01\xb7\xd2\x9f\xbf\xe8\x77\x43\x79\x3a\x55\x46\xa9\xf4\x2b\x94\x0e\xb5\xef\x30\x7b\xc7\x88\x33\x37\x0b\xcd\x03\x94\x53\xc0\x51\x90\x55\x2e\x71\xbd\xf0\x01\xc3\x7e\xa2\xbb\xdf\xed\xb5\xd2\xad\x27\x9e\x13\x21\xf7\x0f\xc2\x17\x57\x88\x78\x72\x7f\xd8\xf2\xa0\x08\xe8\x30\x0a\x49\x3c\x3b\xb5\xef\x12\xba\x1b\x2d\xc5\xb7\xa2\x2e\x38\xba\xa0\x13\x98\x6b\x27\x74\x71\x7e\x97\x2c\xa5\x43\x3d\x2b\xaf\x20\xe4\xbe\x2b\xc8\x8b\x8b\x49\x85\x33\x08\x79\xb2\x22\x49\x6b\x6e\x6d\xc3\x6d\x26\x10\x1a\x64\x78\xad\x88\x2c\x29\x53\xd2\x63\x5d\x2f\x98\x6f\x7a\x28\x60\xb5\x81\xc8\x74\x1c\x50\xcd\xcb\x74\x0e\xa0\x32\xca\x5c\x18\x7e\x6d\x14\x1a\xe2\xeb\xa4\x09\xb6\x43\x46\xf8\x5a\x2e\x0c\x3d\x40\xc4\x1a\xa9\x8b\xca\x67\x64\x89\xd8\x3a\x77\xde\x96\xd7\xbb\xfd\xf8\x68\x6d\x11\x91\x77\xad\xae\x9c\x33\xb1\x1d\x8e\x4b\xb9\xc0\xea\x6d\x77\x05\x01\x18\x51\x3b\x94\x9b\xc8\x75\x5d\xb5\xac\xeb\x66\x23\x3e\x64\x97\x87\xd8\xa3\xf1\xb1\xe3\x80\x01\x82\x8d\x03\x4c\x7c\x5e\x48\xfc\x5c\x94\x31\xec\xf8\x00\x5d\x1b\x89\x4c\xd5\x2c\xf8\xf3\xd9\x03\xb8\x22\x6c\x2d\x13\x6d\x2b\xc7\x10\xcd\x67\xe1\x10\xba\x80\x8a\xb7\x0f\x91\x5f\x59\x46\xb9\x10\x8f\xfe\x41\x3d\xf9\x06\x80\x39\x0d\x84\xd6\x38\x91\xaf\xd3\x60\xdd\x81\x4a\x27\x44\xb1\x4e\xe2\xb9\x25\x76\x32\xea\xab\x9e\x94\xfb\x2e\x5d\x8b\x32\x2f\xd9\xc0\x1e\x74\x7a\xaf\x3b\x7d\xc3\x69\x79\xcc\x49\xdb\xe8\xc6\x47\xe5\x86\x23\xfc\xcf\xd3\x46\xe3\x77\x29\x72\xde\x21\x57\xdc\x2d\x33\xd3\xd8\x3f\x4f\xf3\x2b\x28\x65\x3f\x8e\x5e\x62\x95\x66\xd7\x4c\x0f\x99\x61\x67\x8c\xc2\x86\xcc\x73\x12\xba\xf8\xe9\x81\x48\xb9\x45\x2f\xcd\xd8\xd7\x89\x7f\xee\xf2\x70\xa7\xef\x85\x42\x67\x9e\xd3\x28\x19\xab\xd6\x78\x9e\xb8\x86\x2b\x6d\xce\xf3\x4e\x24\x4f\x90\xac\xbe\x3e\xbd\x62\x73\xcb\x3c\xe4\x3e\x5f\x23\x12\x73\x68\xa4\x9a\x41\xee\x4f\x71\x2f\x7d\x18\x95\xe2\x7e\x70\xbe\x95\xe1\x3e\x63\xe2\x8b\x7a\xd4\xb8\xbd\x6a\xa7\xcd\xdd\xdf\x3c\xec\x6a\xb5\xd2\x3b\x3b\x79\x4e\x13\x5f\xab\x65\x80\xe4\x42\x36\x82\x45\x37\x7f\xda\xa3\x85\xe3\xf1\x28\xba\xf2\x8c\x0b\xc1\xd2\x23\x43\x71\xd3\x2b\x42\x61\x96\x45\xc4\x9d\x57\xe5\x18\x92\x2b\xc3\x1d\x0b\xa6\xa1\x82\xe5\x6f\x36\xc7\x7c\x18\xdd\x90\x19\x76\xbb\xe5\xca\x6e\x72\x15\x6d\x0c\x96\xe2\xf9\x12\xfc\x2c\xf2\xfc\x73\x3d\xcc\x07\xda\x0d\x5c\x2a\x1d\xf4\x09\x5d\x2e\xe3\xe3\x78\x0e\xdc\xb1\x75\x6e\xec\xb7\x10\xc3\x56\x18\x60\xc7\xe2\xd4\xf2\x5d\x64\x63\x6b\x09\x33\x4c\x7c\x17\xfe\x90\x14\x81\x65\x67\xa3\x76\xd7\x60\x6b\x0b\x18\x8a\xd9\x11\x69\xcb\x0a\x7c\xa0\x29\xd1\x41\x1a\x3e\x28\x39\xca\x28\x37\xe8\x4e\xbb\x5b\x7c\x8f\xa0\x4f\x66\xfe\x56\xd5\x28\xb8\xbd\xfd\x65\x2f\x7f\x25\x9a\xf3\xb3\x66\x3e\x99\xb2\xeb\xdd\x0a\xdd\x8a\x01\xa7\x88\xec\x37\x46\x0e\x6e\x4d\xe3\x55\xcb\xe3\xe7\xb8\x4d\xb9\xc7\xc8\xf2\xcc\x2c\x4e\xbd\x10\xdf\xa0\x72\xef\x65\xec\x36\xa2\xeb\x3f\x93\x6e\xf0\x4c\xba\xbd\x67\xd2\x0d\x0b\x97\xfb\xb9\x77\x2b\x62\x3e\x9b\xd9\xae\x24\xcc\xf5\x36\x0e\x61\xcf\x12\xd3\x7f\x19\x31\x83\x97\x11\xb3\xf7\x32\x62\x86\x1b\x89\x31\xb8\xc9\x99\xb8\x30\x89\x3e\xea\xa0\x4c\xde\xb3\xed\x1d\xf6\x0a\x88\xe8\x35\x57\x8a\x38\x78\x53\x40\x8c\x31\x66\xd7\x97\x9f\xc1\xd1\x0a\x7e\xd6\x5e\x70\xee\x1f\xed\x1a\xb3\xbe\xee\xa5\x51\x10\xb3\xda\x47\x26\xa8\xae\x69\xdb\x68\xb6\x8d\x44\xf5\x5f\x4e\xd4\xe0\xe5\x44\xed\xbd\x9c\xa8\xe1\x26\xa2\x4a\x7c\x2f\xf2\xac\x3f\xdf\x73\x32\x0f\xfe\xd3\x3d\xe7\xa7\x8a\xaa\xf6\x9c\x9f\x2a\xaa\xda\x73\x7e\xaa\xa8\x6a\xcf\xc9\x89\x2a\xf5\x9c\x30\xba\x94\x06\xce\x9b\xd4\x06\xa9\xaf\xbc\x2d\x93\x9f\xc4\x32\x09\xdc\x20\x2b\x6f\xc8\x19\x80\x3b\x26\x60\xc6\xac\xdf\x94\x59\xbf\x01\xb3\x41\x53\x66\x83\xff\xc9\x31\xd7\x33\xdb\x6b\xca\x6c\xaf\x01\xb3\x61\x53\x66\xc3\x5b\x65\x09\x3c\x67\x6f\xa8\x7c\xc3\x16\x3f\x29\x54\xdf\xf6\xea\x47\xd4\x1b\x15\xf3\x92\xb6\x66\x07\x98\xd5\xf3\xfa\xdb\xe6\x70\x1a\xc8\x47\x16\x84\x7a\xf1\xab\x62\xf5\xa7\xad\xed\xae\x8e\xc8\xbe\x9b\xa0\x1e\x67\x64\x1a\x82\x22\x97\xd4\xc5\xb0\xe5\x27\x1e\x51\xb8\xa4\x6f\xd5\x54\x7a\x79\xe0\x50\xc9\x5f\xdc\x52\xfb\xf1\xd7\x2e\xc1\x6e\x76\xa6\x73\x1c\xbf\x66\x93\xe7\x16\xbb\x4c\x93\x28\xb9\xb6\xa7\x83\xe1\x9b\xc3\x43\x64\x77\xf6\xfb\x87\xbd\xce\x70\x80\x7a\x1d\x34\x3d\x3c\xec\x0c\x7a\xb3\x83\xbd\xc3\x81\xe3\x0c\x86\xb6\xf6\xc2\x11\x89\x0f\x6a\xfe\x2b\x54\x47\xb6\xe3\x1c\x0c\xd0\x41\x67\x6f\xef\xf0\x75\x67\x78\x88\x67\x9d\xa9\x33\x1c\x74\x66\xfb\xbd\xfd\xd9\x14\x1d\xf6\x11\x3e\x50\xef\x5b\x6d\xea\x63\xe3\xa3\x4c\x92\xcd\x0f\x57\x1f\x8a\xe7\xf4\x4e\xfa\x32\x30\x62\x73\xcc\xcf\xbc\x15\x61\xd4\x4b\xce\x03\x34\xdf\x2d\x20\x0a\x57\x63\x67\xde\x9c\x78\xf8\x94\xde\x7b\xe2\xdc\xed\x12\xfb\xb4\xe4\x8a\xac\x08\x2c\xe1\xa5\xbc\x5a\xed\x77\xfb\x83\xee\x5f\x5b\xf1\x0b\xad\xe8\x8b\x9a\xec\x98\x3b\xfa\xb8\x21\xb9\xda\x12\xcf\xeb\x14\x40\xdc\xd9\xb2\x8e\xe2\x40\xaa\xbc\xe5\xb6\x1e\x1f\x19\xf2\xe6\xd8\xb2\x5e\xad\xe4\x4b\x87\x1d\xf8\x43\x1c\x5d\x59\x47\x6f\x73\x62\x74\x19\x19\x3d\xe8\x13\xd3\x3e\x3d\x81\x68\x75\x71\x29\xb0\xdc\xbf\xc5\x24\xca\x89\xbb\x89\x9e\x9f\x17\xfb\x01\x41\x0a\x5f\xb9\xc8\x61\x7d\xc2\x6b\x49\x35\x3a\x7d\x7c\x4c\x25\xa7\x5b\x4f\xf5\xbf\xa7\x9d\xa2\x58\x39\x3a\xe5\x23\x68\xa5\xd8\x2b\x5a\xe5\x95\x9d\x18\xc5\x06\x0a\x61\x93\xc8\x3a\xdd\x9b\x3c\x97\xc2\x88\x33\xe3\xd8\x75\xc6\x31\x1b\x48\x6a\x6b\x67\x22\xae\x99\x0b\x53\xd8\xd4\x1e\x8a\x6e\x10\xbd\xa1\xc3\xae\x32\x14\x98\xca\x60\x03\xb3\xae\xb7\xb9\x5f\x32\x84\x4e\x71\x5b\x7c\x55\x18\x7f\xbf\x95\xb8\x69\xeb\x3e\xfa\xb7\xf6\x85\x4d\x61\xcd\x98\x40\xca\x7a\x51\xbb\xc7\x28\x08\xee\x29\x2b\x7e\x1b\x62\x02\xe5\x2e\xc5\xdf\x11\x0f\x89\x8f\x46\x27\xc7\x13\xf9\x01\x9a\x21\x75\xe9\x90\x12\xfa\xb2\x97\xe6\x06\x4c\x71\x14\x57\xd8\xc5\x40\xc0\xd6\x1f\xae\x0d\x8f\xd2\x4c\xa0\x7c\x02\x4d\x3e\x91\x53\x5f\xd7\xa7\x91\x38\xee\x8c\xf2\xb3\x89\x2c\x7d\xc9\x5f\x8b\x9c\xdc\x85\xe9\x4d\x98\xf8\x24\xc8\xc6\xe2\xc0\xb9\x73\x4f\xf8\xa2\x93\x7e\xb8\x1d\x98\x28\xcb\x0c\x64\xc0\xa8\x21\x9f\x78\x73\x17\xff\x1e\xd2\xe8\xff\xdc\xa0\x9d\x33\x5c\xf4\xbc\x2f\x7a\x2d\x99\x7d\x72\x61\xbd\x22\x9e\x1f\xf2\xf7\x10\xbe\xac\xb7\x56\xfb\x97\xc9\xdf\x27\x57\x67\xe7\xa7\x97\xa3\x9b\xb3\x5f\xbe\x7e\x3d\xfe\x1e\x32\x2c\x74\xff\xfa\x35\x22\x17\x7f\x77\xa7\xc4\x6b\x5b\xbf\x5a\xaf\x68\xc8\x37\x24\x9d\x60\x1e\xfa\x91\x0a\x5d\x3f\xe8\x0b\x2e\x27\xd4\x5f\x77\x46\x1c\x2f\x55\x4d\x54\xd6\xbf\x5a\x23\x6f\x45\xef\x70\xe7\xec\xc1\x17\xc7\xc4\x22\x3d\xb5\x1f\x7b\x4f\xd6\x63\xff\xa9\x6d\x75\x66\x2a\x18\xa2\x10\xe4\xa0\x50\xde\x56\x6e\x03\x65\x2b\x59\x60\xff\x06\x02\x3d\xf7\x95\xb0\x43\x00\x00")
 
 func kubernetesmastervarsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1217,7 +1217,7 @@ func kubernetesmastervarsT() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x9a\x5b\x6f\xe3\xb8\x15\xc7\xdf\xf7\x53\x1c\xf8\x69\x0b\x24\x9e\x4c\x27\x5d\x2c\x82\xa2\x45\xc6\x4e\x37\xee\x24\x59\x37\xc9\x6c\x1f\x8a\x3e\xd0\xe4\x91\xc4\x86\x22\x35\xe4\x51\x1c\xc7\x9b\xef\x5e\x90\x92\x6c\xf9\x12\xd7\xba\x0c\x50\x0c\x06\xe3\x91\xcd\x1f\xff\xff\xc3\x43\x8a\xb7\xe5\x52\x46\x30\xbc\x66\xee\x92\x89\xa9\x35\x91\x54\xf8\xf6\xf6\x03\x00\xc0\x80\x31\xf1\x80\xf6\x19\xed\x65\x96\x4d\xc4\xe0\x02\x96\xe1\x39\xc0\x20\x45\x62\x82\x11\xab\x3d\x03\x18\x08\x74\xdc\xca\x8c\xa4\xd1\x83\x0b\x18\x3c\x26\x08\x2e\x94\x87\xcb\xcb\x31\xb0\x2c\x53\x92\x33\xff\x2d\x4c\xc6\x83\xb2\xd8\xdb\x49\xc5\xa4\x45\x86\xbe\x98\x23\x2b\x75\x5c\x7c\x5f\x7e\xeb\x95\x3c\xa2\x66\x9a\x36\x65\x08\x8c\x58\xae\xe8\x37\xa6\xf2\x50\x74\x70\xd2\x58\xa0\x57\x46\x01\x0d\x93\x31\x90\x81\xdc\x21\x44\xc6\x02\xcb\x29\x41\x4d\xa5\xe2\x21\x4c\x22\xd0\x86\xc0\x65\xc8\x65\x24\x51\x9c\xc0\x5c\x2a\x15\x7e\x4e\x09\x56\x0c\x13\x85\xff\x09\xcc\x94\x59\xa4\xa8\x09\x5c\x3e\x5b\x55\x3a\x3c\xda\xf5\x72\x89\x5a\xac\xda\x21\x93\x45\x3b\x8c\xd0\x92\x8c\xbc\x24\x6c\xd5\x1a\x33\xe6\x10\x7e\x3a\xaf\x5a\x85\xaf\x71\xde\x87\x00\xa3\x83\xfa\x94\x39\x42\xdb\xb0\x85\x2a\x8d\x53\x2b\x9f\x19\xe1\x17\x5c\xf4\x21\x31\x2b\x68\xf0\x84\x8b\x3d\x12\x0f\xc5\x13\x79\x6e\x71\x9f\x52\xce\xfa\x0a\x63\x3d\x7e\x3e\x5d\x8c\x95\xb4\xa8\x3f\x6d\x16\x42\xce\xf6\xc6\x6e\xb9\x9c\x9a\x2c\x57\x8c\x70\xa4\x98\x73\x92\xdf\x1a\x81\xe3\x5a\xe6\x6f\x95\x2c\xb3\xa6\x8d\xa1\xd1\x65\xcf\x01\x0f\x83\xcb\xc4\x5d\x1b\x47\x28\x6e\x03\xa2\x4a\xea\xa7\x7c\x86\x56\x23\xa1\xbb\xd2\x22\x33\x52\x53\xab\xb6\xf8\xb2\xc2\xc0\xe5\x74\x02\x58\xb2\x20\x21\xca\xdc\xc5\x87\x0f\x7f\xde\xad\xe7\x2f\x17\xe7\xe7\x9f\xda\xf5\x44\xae\x24\x6a\xea\x2d\x7f\x02\x6d\xb7\x1b\x92\x01\x6e\xd2\x34\xd7\xc5\xb3\xb9\xa4\xa4\x75\xbf\x2c\xea\xe8\xa9\x53\x96\x82\x77\x72\xa4\xb5\xe0\x77\x3b\xa9\x6f\xb5\x91\xd1\x91\x8c\xbf\x47\x67\x0d\xa2\x67\x0b\xef\xa7\xd7\x60\xaf\x55\xf7\x14\xf0\x9d\x48\x77\x15\xfd\x6e\xc0\x63\xd4\x68\x19\x19\x3b\x32\x02\x9b\x8d\x3e\x9b\x45\x5b\x0c\x3f\x2b\x00\x70\x23\xd6\x7d\x40\x0a\xff\xfe\x8d\x16\xc1\xd9\xea\x37\xcd\x5a\xc4\x58\x9e\x78\xbf\xbe\xe4\x1d\x4b\x1b\x3a\xdb\x29\xdd\xc2\x5c\x9d\x01\x9a\xa5\xef\xf8\xab\xff\x6c\x08\xf0\x98\x48\x07\x69\xee\x08\x66\x08\xda\x40\x6a\xac\x9f\x67\x30\x0d\x9f\x40\xc8\x58\x92\x03\xa9\x41\xa1\x8e\x29\x39\x01\x43\x09\xda\xb9\x74\x08\x92\x8a\x69\x09\xbe\x70\x44\x01\xff\x94\x5a\x98\xb9\x83\x3b\x96\xae\x42\x53\x0f\x5d\x2a\xf5\x4d\x60\x0c\x2e\xe0\xd3\xfa\x29\x7b\xd9\xf3\xf4\x50\x98\x85\xe1\x4f\x68\x3f\x5b\x29\x62\x1c\x49\x61\x9b\x85\x79\xa7\x74\xc3\x30\x8f\x43\x79\x98\x05\x00\x68\xa4\xb9\xb1\x4f\x30\x99\x02\x13\xc2\xa2\x73\xc0\xb4\xf0\xf3\x30\x8d\xd4\xa2\x3f\xab\xdc\xf7\xa9\xe6\xae\xb6\x0b\x37\x34\x55\x7b\xb1\xf1\x82\xd2\xda\xc2\xf8\xee\xc1\xcf\xcd\x24\xc7\xc9\xb4\xb9\x87\x8d\xd2\xed\x4d\x8c\xef\x1e\x60\x32\x6d\x2e\xbe\xac\xbb\x5d\xfc\xeb\x85\xdb\x4b\x77\x05\x65\x95\x4e\x2e\x63\xbc\xe1\xdc\x6e\x3d\x0b\xb9\x33\xfa\x96\xb9\x6f\x39\x5a\x26\xb6\x5d\x1d\xa7\xec\x00\xab\x73\xaa\x14\xdc\xeb\x45\x86\x36\xc4\x2f\x43\xde\x3c\xea\xfb\x20\x2d\x46\x4e\x6e\x34\x31\xa9\xbd\x9b\x0c\x79\x58\x94\x25\x15\xf3\xf8\x95\xd4\x96\xb5\x4b\x21\x7c\xd0\x34\x8b\xd1\x76\x71\xb7\xc3\xf9\xbf\x32\x78\x8f\x4e\xbe\xf6\x60\xb0\xce\xe9\xc7\x20\xf3\xd8\x53\x5b\x70\x5b\x9b\x1c\x33\x97\xcc\x0c\xb3\xa2\x8b\xc3\x4d\x48\x3f\xf6\xd6\xf4\x53\x51\xe1\x4f\x59\x2a\x7e\x3a\x6f\xed\xf5\xea\x05\xf9\x35\x32\x45\xc9\x6b\x17\xb7\xdb\x98\x7e\xfc\xe2\x0b\xf2\xa4\xa0\x76\xb4\x79\x8d\x2c\xf3\x43\x57\xa7\x21\xa7\xce\xe8\xa9\x43\x96\xc8\xd6\xbe\x1e\xa5\x52\xdd\x5c\xd5\x08\xfd\x78\xba\x46\x95\x42\x41\x6d\x6d\x6b\x6a\xc4\x44\x47\x96\x8d\x2a\x7c\x17\x87\xfb\x61\xfd\x98\xcd\x8c\x00\xe9\xe1\xad\xad\xde\x19\x81\x0f\xc4\x28\x77\x5f\x33\xc1\x08\xff\x66\xf1\x5b\x8e\x9a\x37\xdc\xa4\x39\x06\xd8\x62\x8e\xa2\x90\xbc\xed\x48\xc6\xc1\xae\xf6\x2b\x28\x17\xe0\x90\x07\x3a\x44\x15\x1e\xa4\x26\xb4\xcf\x4c\xb5\x0e\xc5\x88\xac\xba\x8d\xad\x37\x70\x6b\xb4\x24\x63\x7f\xb1\x8c\xe3\x14\xad\x34\xa2\x6d\x38\x0e\x43\x3b\x4c\x9b\x8d\x26\x6b\x7c\x96\x43\x5a\xbc\xa8\x21\xf6\x60\xc8\x02\xf9\x9d\x70\xb9\xae\xc1\x99\x1a\x71\xf5\x2c\xb9\x17\xf4\x28\x53\x34\x39\x75\x0c\xcc\x1e\x60\xaf\x41\xf1\x3d\x04\xcb\x0a\x80\x8a\x1a\xba\x06\xe1\xde\xe4\x84\xf7\xc8\x8d\xe6\x52\xc9\xb0\x85\xde\x4b\x92\xbc\xcf\xed\x35\x24\xd6\x57\x03\x76\xa3\x9e\x32\x6d\x1a\x46\x86\x2b\x93\x8b\xa9\x35\xcf\x52\xa0\xfd\xcc\xf8\x93\x89\xa2\x86\x9b\xbb\xfb\x08\x0d\xdd\x5e\x69\x36\x53\x08\x01\x95\x95\x28\x98\x15\xac\xbf\x76\x37\x74\x8f\x64\x25\xba\xee\xbe\x2a\x50\x43\x7b\x93\xa8\x32\x03\x18\x9c\x8a\x13\x48\xcc\xdc\x37\xe7\x22\x64\xb4\x03\x32\x60\x91\xec\xa2\xbb\xd9\xab\x97\xcc\x68\xd4\x0d\x7b\xf5\x41\x52\x0f\x76\x83\x39\xc0\x8a\xd8\xd9\xe5\x38\xb7\xac\xac\xaf\xa3\xcb\x15\xa9\xa7\x46\x55\x46\xc7\x90\x6b\x92\xaa\x1a\xac\xba\xbb\xfd\xbb\x24\xc2\x86\xfb\x0b\x07\x38\x3d\x38\xfd\x4f\x20\x41\xc4\x38\x19\x0b\x33\xa4\x39\xa2\x0e\xad\xec\xfb\x47\x7b\xc3\xf7\x8c\x50\xc9\x54\x76\x49\xdf\x35\xa3\x8f\x61\xc8\xfa\x39\x4a\xc0\x49\x1d\x77\x19\x8c\x56\xb2\xfe\x31\x7d\xe8\xc3\x9d\xc7\x34\x6f\xc9\x0d\x3b\xeb\xf6\x24\x66\x63\x24\x48\xd9\x8b\x4c\xf3\x14\x3c\xbb\x07\xa7\x9f\x73\xfe\x84\xbd\x34\x65\x49\xea\xcd\xef\x2c\xf0\xc0\x2f\xf3\xdb\x4e\x25\xbe\x94\x9b\x8f\x1d\x16\x16\x75\x44\x7f\x0b\x7c\xa1\x5d\xc7\xc5\xee\xf8\xee\xe1\x96\xb9\x6f\x9d\x76\x2f\x6a\x88\xfe\xac\x9d\x0a\xed\x52\xe6\xbe\xb5\xf2\x57\xec\xe3\x5f\xe9\x58\x6a\x1c\x9b\xb9\x56\x86\x89\x7b\xcc\xcc\xa1\x2b\x1b\xd5\x39\x2d\xcb\xa8\x28\x3e\x64\xaf\xb9\x45\x14\x31\x0e\x35\xd2\x07\xeb\xcb\x37\xbf\xd6\x51\xb0\x00\x83\x16\x10\xa5\x18\xc8\xad\xda\xda\xa6\x69\x68\xb1\x3c\x5c\x98\x1a\x25\x37\xd6\x7f\x3b\xbe\x96\xcb\xe1\xaf\xb5\x23\x9d\xf2\x66\xcd\x70\x3d\xed\x2c\xce\x09\x87\x77\x75\xe0\xdb\x5b\x0b\xab\xd5\x79\x47\x16\x10\x80\x3a\x32\x96\x63\xb8\x7b\x52\x5e\x67\xf9\x51\x1b\x8d\xbf\x87\xb8\xfe\xce\x99\x92\xdc\xfc\x61\xd7\x35\x53\xca\xcc\x51\x04\x03\x7e\x26\xf7\xaf\x75\xa5\xbe\xf8\x4a\x98\xff\xa9\x27\xd5\x1f\x14\xd0\x8a\xf9\xef\xe3\x06\x34\x2d\xa7\x2a\x8f\xa5\x76\x5f\xef\x6f\x8e\xca\x10\xee\x4e\x53\x69\xad\xd9\x4e\x11\xae\xa5\xff\x7b\x9a\x15\xb8\x22\x75\x4f\x7d\x37\x72\x34\xa4\xf8\x75\x70\x94\x9e\x67\x8d\x34\xd2\xf2\x46\xea\xfc\xa5\x47\x61\xe1\xc9\xa9\x87\x9f\x7a\x8d\xca\xe3\xbb\x29\x2c\xcf\xf4\xbe\x9f\xc6\x79\x51\xc1\xa6\xca\x57\x99\x1d\xa7\x32\x65\x2f\x53\x23\xdc\x01\x51\x1f\x3f\x9e\x35\xcf\xf2\xea\xc5\xa9\xf3\x74\x86\x16\x4c\xe4\x97\xad\xce\xaf\xc9\xc2\x1a\xfe\x40\x37\x96\xd5\x74\x78\x23\x8e\x5b\x07\x2f\xdb\x71\xfb\x78\x36\x0c\x7f\x3e\xfc\xdc\xb0\x47\x96\x27\x7e\xe0\x2b\x01\xee\x6b\x69\x34\xbe\xc4\xfc\x5a\xc6\xc9\x63\x62\xd1\x25\x46\x1d\xba\xec\xf6\xf3\x9f\x9a\x09\xf3\x5c\x58\x81\xc3\x28\x38\x49\x59\x8c\xf0\x0b\xb3\x33\xff\x2f\xf7\x4b\xe0\x62\x13\xc0\x68\x40\xc6\x93\x10\xd9\x06\x81\x8d\xf9\x8d\x99\x1f\xa5\xbd\x61\x02\xdc\x98\x79\x1b\xe9\x47\x26\xc5\x72\x09\xb2\xb8\xdc\xf7\xd5\x61\x71\xa4\x23\x26\xe1\x6c\x9e\x16\x50\xdd\xfc\x29\xcf\x00\xa7\x56\x6a\x2e\x33\xa6\x46\xe1\x2a\x4c\x8b\x5b\x91\x45\x41\x98\x8c\xe1\xc7\xf5\x8d\x8e\xda\x7c\x78\xcf\xd0\xfc\xbf\x6f\x6f\xec\x57\xf7\x80\xdc\x62\xbb\x6b\x55\xe5\xc1\x29\xac\x88\x50\xea\x2e\x98\xcd\xef\x81\x01\x6a\xb1\x0a\x66\x71\x4d\xe5\xd7\x28\x72\x9b\xf2\xb6\xd2\xe4\xec\x88\x97\xd3\xd9\xfa\x35\xf4\x71\xfd\xf1\x8f\xeb\x8f\x9f\xd6\x1f\xcf\x77\x5e\x50\xc7\xdf\xe2\x08\x5a\x41\x6a\x32\xb5\x8b\x36\x90\x19\xa3\x60\x9e\xa0\x45\xff\xae\x75\xc4\x2c\x01\xb7\xc8\xc2\x5c\xb8\xfc\xcd\x6f\xb7\xae\xba\xd0\xf1\x1c\x26\x71\x9c\x69\x98\x21\x44\xd6\xa4\x70\xe6\xcb\x9d\xfb\xe9\x32\xad\xae\x7b\x28\x74\xae\xb8\xeb\x51\x10\x46\x26\xd7\x87\x22\xbe\xce\xe5\x1f\xfe\x1b\x00\x00\xff\xff\xd5\x0e\x80\xb6\xcd\x2b\x00\x00")
+var _kubernetesparamsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x5a\x6d\x73\xdb\xb8\x11\xfe\x7e\xbf\x02\xe3\x4f\xd7\x19\x4b\x71\x1a\xf7\xe6\x26\xd3\x69\xc7\x91\xdd\xb3\x9a\xd8\xa7\xda\xce\xf5\x43\xa7\x1f\x20\x60\x49\xa2\x26\x01\x16\x00\x2d\xcb\x3a\xff\xf7\xdb\x05\x49\x89\x7a\x89\x2b\xbe\xdc\x4c\x93\x78\x22\x43\xc4\x83\xe7\x59\x2c\xc0\x5d\x60\x57\x2b\x15\xb1\xf1\x35\x77\x17\x5c\xce\xac\x89\x54\x0a\xaf\xaf\xdf\x31\xfc\x73\xc2\xb9\xbc\x07\xfb\x04\xf6\x22\xcf\xa7\xf2\xe4\x23\x5b\x85\x76\xfc\x26\x03\xcf\x25\xf7\xbc\xd1\x86\xad\x12\x9c\xb0\x2a\xf7\xca\x68\xfc\xe2\xe4\x21\x01\xe6\x42\x7f\x76\x71\x71\xc9\x78\x9e\xa7\x4a\x70\xfa\x96\x4d\x2f\x4f\xaa\x6e\xaf\xa7\x35\xa6\x5f\xe6\x40\xdd\x9c\xb7\x4a\xc7\xe5\xf7\xd5\xb7\xc4\xe4\x01\x34\xd7\x7e\x9b\x86\x84\x88\x17\xa9\xff\x85\xa7\x45\xe8\x7a\x72\xda\x9a\x20\x31\xf3\x01\x1a\x49\x31\x6f\x58\xe1\x80\x45\xc6\x32\x5e\xf8\x04\xb4\xaf\x18\x8f\xd9\x34\x62\xda\x78\xe6\x72\x10\x2a\x52\x20\x4f\xd9\x42\xa5\x69\x78\x1c\x1f\xac\x31\x4c\x14\x7e\x93\x90\xa7\x66\x99\x61\x7f\xe6\x8a\xf9\x7a\xd0\xf1\xd1\xaa\x57\x2b\xd0\x72\x3d\x0f\xb9\x2a\xe7\x61\x02\xd6\xe3\xe0\x48\x09\x3a\xcd\xc6\x9c\x23\xdb\x1f\xce\xeb\x59\x11\x1b\x38\xd2\x21\x19\xce\x0c\xb1\xcf\xb8\xf3\x60\x5b\xce\x50\xcd\x71\x66\xd5\x13\x02\x7e\x86\xe5\x10\x14\xf3\x12\x8d\x3d\xc2\xf2\x00\xc5\xb7\xec\x09\xa2\xb0\x70\x88\xa9\xe0\x43\x99\xb1\x69\x3f\x72\x17\x63\x95\x5f\x36\x5b\xdb\x99\x50\xf0\x83\xb6\x5b\xad\x66\x26\x2f\x52\x6c\x9f\xa4\xdc\x39\x25\x6e\x8c\x84\xcb\x86\xe7\xef\xf4\xac\xbc\xa6\x8b\xa0\xc9\xc5\xc0\x06\x5f\xd1\xe6\x32\x75\xd7\x06\x3b\xcb\x9b\x00\x51\x3b\xf5\x63\x31\x07\xab\xc1\x83\xbb\xd2\x32\x37\x4a\xfb\x4e\x73\xf1\x79\x0d\xc3\x2e\x66\x53\x06\x15\x16\x4b\xbc\xcf\xdd\xc7\x77\xef\xfe\xbc\x3f\xce\x5f\x3e\x9e\x9f\x7f\xe8\xb6\x12\x45\xaa\x70\x4d\x0f\xe6\x3f\x01\x6d\x7f\x19\xe2\x2e\x24\x4c\x96\x15\xba\x6c\x5b\x28\x9f\x74\x5e\x97\xe5\x18\x03\x2d\xca\x8a\xf0\x9e\x8f\x74\x26\xfc\xcd\x45\x4a\xb3\x36\x31\x3a\x52\xf1\xef\xb1\x58\x03\xe9\xf9\x92\xf4\x0c\x6a\xec\x0d\xeb\x81\x0c\xbe\x67\xe9\xbe\xa4\xbf\x69\xf0\x18\x34\x58\xee\x8d\x9d\xe0\xee\xd2\x6e\xf7\xd9\xee\xda\x61\xfb\x59\x03\xa0\x28\xb9\x59\x03\x4a\xd2\xfb\x37\x5a\x06\x65\xeb\x67\xda\xcd\x88\xb1\x22\x21\xbd\xd4\xf3\x96\x67\x2d\x95\xed\xf5\xee\x20\xae\x89\xc1\x34\x82\x1c\xd6\xd7\x7c\x6c\xcc\xd8\x43\xa2\x1c\xcb\x0a\xe7\xd9\x1c\x30\xee\x60\x99\xb1\x14\x67\x70\xcd\x3e\x30\xa9\x62\xe5\x1d\x53\x9a\xa5\xa0\x63\x9f\x9c\x32\x83\x08\x76\xa1\xd0\x67\x94\x2f\xc3\x12\x78\x16\x80\xa3\xfc\x53\x69\x69\x16\x8e\x21\xf9\xb5\x69\x9a\xa6\xc3\xd6\x2f\x01\x03\xd9\x7e\xd8\xb4\xf2\xe7\x03\xad\x6f\x99\x59\x1a\xf1\x08\xf6\x93\x55\x32\x86\x89\x92\xb6\x9d\x99\xf7\x7a\xb7\x34\xf3\x65\xe8\xcf\xe6\x01\x80\xe1\x5e\xbf\x30\xf6\x91\x4d\x67\x8c\x4b\x89\xde\xee\x18\xd7\x92\xe2\x30\xfc\xa6\xc3\x7a\x4e\x0b\x5a\x53\xed\x55\xed\x76\x6e\x29\xaa\xf1\x62\x13\x25\x4a\x67\x09\x97\xb7\xf7\x14\x9b\x29\x01\xd3\x59\x7b\x0d\x5b\xbd\xbb\x8b\x40\x18\x9c\x92\xf6\xe4\xab\xb1\xbb\xd9\xbf\xd9\xb9\x3b\x75\x57\xa2\xac\xdd\xc9\xe5\x5c\xb4\x8c\xed\x36\x51\xc8\xad\xd1\x18\x08\xfd\xb7\xc0\xdd\x4c\xee\xaa\x3a\x8e\xd9\x1b\x58\xbd\x5d\xa5\xc4\xbd\xc6\xa7\x6c\xb0\x1f\x66\x3b\xed\xad\x7e\x08\xa4\xc3\xce\x29\x8c\xf6\x5c\x69\x52\x83\x08\x21\x29\x4b\x6a\xcc\xe3\x33\xa9\x1d\x69\x17\x52\x92\xd1\x34\x8f\xc1\xf6\x51\xb7\x87\xf3\x7f\x25\xf0\x0e\x9c\x7a\x19\x40\x60\x13\x67\x18\x81\x9c\x60\x47\xb6\xc4\xed\x2c\xf2\x92\xbb\x64\x6e\xb8\x95\x7d\x14\x6e\x83\x0c\x23\x6f\x83\x3e\x92\x35\xfc\x88\x67\xf2\x87\xf3\xce\x5a\xaf\x9e\x41\x5c\x03\x4f\x7d\xf2\xd2\x47\xed\x2e\xcc\x30\x7a\x01\x51\x93\x12\xb5\xa7\x4c\xe4\x96\xd3\xd6\xd5\x6b\xcb\x69\x62\x0c\xb4\x20\x2b\xc8\xce\xba\x1e\x30\x1c\xeb\xa7\xaa\x81\x30\x8c\xa6\x6b\x48\x33\x56\xa2\x76\x96\x35\x33\x72\xaa\x23\xcb\x27\x35\x7c\x1f\x85\x87\xc1\x86\x11\x9b\x1b\x89\xa1\x32\x82\x77\x96\x7a\x8b\xcc\xef\x3d\xf7\x85\xfb\x9a\x23\x0b\xf8\x9b\x05\x7c\xe5\x6a\xd1\xf2\x90\xe6\x18\xc0\x0e\x31\x4a\x0a\x9e\x64\x63\xce\x19\xe4\x6a\xca\xa0\x5c\x00\x67\x45\x40\x67\x51\x0d\x8f\x76\x40\x4f\x7e\xe2\x69\x67\x53\x4c\xbc\x4d\x6f\x62\x4b\x02\x6e\x8c\x56\x98\xab\xfc\x64\x31\x16\x9a\x81\x55\x46\x76\x35\xc7\xdb\xa0\x3d\xc2\x66\x74\x06\x6b\xc8\xcb\x31\x2f\x0e\x2f\x6a\x16\x13\x30\xcb\x03\xf2\x37\xcc\xe5\xfa\x1a\x07\x9d\xf9\x0a\xc3\x45\x22\xf4\xa0\x32\x30\x85\xef\x69\x98\x03\x80\x83\x1a\x85\x56\x08\x54\x03\x30\x5f\x8e\xd0\xd7\x08\x77\x88\x01\x77\x80\xa3\x09\x95\xaa\x70\x84\x3e\x88\x93\x7c\x1b\x77\x50\x93\x58\x1a\x86\xd9\xad\x71\x2a\xb7\x69\x69\x19\x91\x9a\x82\xee\x55\x9e\x30\xdb\xb7\x9f\xb8\x78\x34\x51\xd4\xf2\x70\xf7\x10\x42\x4b\xb5\x57\x9a\xcf\x53\xdc\x1e\x09\x2a\xaf\xa0\xd8\xbc\xc4\xfa\x6b\x7f\x41\x77\x80\x0f\x82\xeb\xaf\xab\x06\x6a\x29\x6f\x1a\xd5\x62\x18\x04\xa5\xf2\x94\x25\x66\x41\xd3\xb9\x0c\x1e\xed\xe8\xbc\xc5\x22\xf8\xb2\xbf\xd8\xab\xe7\xdc\x68\xd0\x2d\x57\xf5\x9b\x48\x03\xc8\x0d\xe2\x30\x1c\xab\x10\x7b\xab\xbc\x2c\x2c\xaf\xc6\xeb\xa9\x72\x8d\x34\xd0\xa4\xa6\x46\xc7\xac\xd0\x5e\xa5\xf5\x66\xd5\x5f\xed\xdf\x95\xa7\x73\xd3\xde\x5a\x2b\x9c\x01\x94\xfe\x27\x20\xb1\x88\x0b\x3a\x37\x9c\x83\x5f\x00\xe8\x30\xcb\xb4\x3e\xba\x0b\xbe\x43\x49\xa9\xca\x54\x1f\xf7\xdd\x60\x0c\xb1\x0d\x59\x8a\x51\x02\x1c\x32\xef\xb3\x19\xad\x69\xfd\x63\x76\x3f\x84\x3a\x82\x69\x3f\x93\x5b\x72\x36\xf3\xe9\xb9\x8d\x31\x52\xcb\xf8\xb3\xca\x8a\x8c\x11\xf6\x00\x4a\x3f\x15\xe2\x11\x06\x99\xca\x0a\x69\x30\xbd\xf3\x80\xc7\x28\xcd\xef\x1a\x4a\x7c\xae\x0e\x1f\x7b\x24\x16\x4d\x88\xe1\x12\x7c\xa9\x5d\xcf\x64\x17\x39\xd1\xc1\x5d\xaf\xd3\x8b\x06\xc4\x70\xd2\x46\xa8\x2d\x43\xd8\x4e\xfa\xca\x73\xfc\x2b\x1d\x23\xee\xa5\x59\xe8\xd4\x70\x79\x07\xb9\x69\x9e\x70\xee\x96\x6c\xd4\xf7\xb4\x3c\xf7\x65\xf7\x31\x7f\x29\x2c\x80\x8c\x61\x8c\x3a\xdf\x59\xea\xdf\xbe\xac\xa3\xc4\x42\x77\x24\x2e\xf8\x5b\x49\x86\x15\x36\xdd\x39\xa6\x69\x29\xb1\xba\x5c\x98\x99\x54\x6d\xe5\x7f\x7b\xba\x56\xab\xf1\xcf\x8d\x2b\x9d\xaa\xb2\x66\xbc\x09\x3b\xcb\x7b\xc2\xf1\x6d\x13\xf0\xf5\xb5\x83\xd4\xfa\xbe\x23\x0f\x10\x28\x19\x05\x0a\x08\xb5\x27\x55\x39\xcb\xf7\x1a\x83\x82\x5f\x83\x5d\x7f\x15\x1c\x9f\x32\x7f\xd8\x57\xcd\xd3\xd4\x2c\x40\x06\x01\x14\xc9\xfd\x6b\x33\x28\x75\x5f\x13\xa3\x47\x09\xa9\xd9\x50\x82\xd6\x98\xff\x3e\x6e\x43\xd3\x6a\x96\x16\x38\x3d\xee\xeb\xdd\x97\xa3\x3c\x44\xb8\x51\xa6\xac\x35\xbb\x2e\x82\x48\xf4\x33\xca\x4b\xb8\xd2\x75\x47\xb4\x8c\x9c\x1f\xfb\xf8\xe5\xe4\x28\x3e\x4f\x08\x35\xd1\xea\x8b\xd2\xc5\xf3\x80\xc4\x42\xcb\x88\xc0\x47\xc4\x31\x25\xf8\x7e\x0c\xab\x3b\xbd\xdf\x8f\xe3\xa2\x1c\x60\x9b\xe5\x8b\xca\x8f\x63\x89\x6f\x38\x4c\x58\xdd\x1b\xa4\xde\xbf\x3f\x6b\xef\xe5\xf5\x8b\x53\x17\x19\xae\x20\xaa\xb3\xc2\xb4\xd5\x51\x4e\x16\x72\xf8\x37\x96\xb1\xaa\xc3\xe1\x2d\x3b\xee\x5c\xbc\xec\xda\xed\xfd\xd9\x38\xfc\x7d\xf7\x63\xcb\x15\x59\xdd\xf8\x31\x1a\x84\x09\x1a\xa5\xd5\xfe\x12\x8b\x6b\x15\x27\x0f\x89\x05\x97\x98\xf4\xad\x62\xb7\x1f\xff\xd4\x8e\x18\xe1\xb2\x35\x70\xd8\x05\xa7\x19\x26\xbb\xec\x27\x6e\xe7\xf4\xbf\xa0\x14\xb8\x3c\x04\xc0\x7f\xc0\x45\x12\x2c\xdb\xc2\xb0\xb1\xf8\x62\x16\x47\x71\x6f\xe9\x00\x08\xdb\x85\xfa\x91\x4e\xb1\x5a\x31\x55\x16\xf7\x7d\x75\x50\x5e\xe9\xc8\x69\xb8\x9b\xf7\x4b\x56\x57\xfe\x54\x77\x80\x33\x9c\x32\xa1\x72\x9e\x4e\x42\x29\x4c\x87\xaa\xc8\xb2\x23\x15\x1b\x7e\xbf\xa9\xe8\x68\xc4\xc3\x07\xb6\xe6\xff\x5d\xbd\x71\x98\xdd\x3d\x08\x0b\xdd\xca\xaa\xaa\x8b\x53\xb6\x46\x64\x15\xef\x12\xb3\x7d\x1d\x18\x55\x66\xad\x8d\x59\x96\xa9\xfc\x1c\x45\x6e\x9b\xde\x8e\x9b\x9c\x1d\xf1\x72\x3a\xdb\xbc\x86\xde\x6f\x3e\xfe\x71\xf3\xf1\xc3\xe6\xe3\xf9\xde\x0b\xea\xf8\x2a\x8e\xc0\x95\x0e\x50\x4d\xa3\xd0\x06\xf7\x20\x93\xb2\x45\x02\x54\x9c\x61\xe8\x1c\xd1\xe2\xa2\xb7\xc0\x43\x2c\x5c\x3d\xf3\xcb\x8d\xab\x0b\x3a\x9e\x42\x10\x27\xb8\xa6\xaa\x8e\xc8\x9a\x8c\x9d\x51\xbf\x73\x0a\x97\xfd\xba\xdc\x23\xa5\x3b\xe6\x50\xeb\x51\x22\x4c\x0c\x66\xbb\x47\xf9\xf2\x77\xbf\x01\xd5\x0e\x80\xb6\xcd\x2b\x00\x00")
 
 func kubernetesparamsTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1237,7 +1237,7 @@ func kubernetesparamsT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\x6e\x3f\x05\x21\x74\x4f\x31\x60\x3b\x4e\xbb\xc5\x1d\x7a\xd8\x05\x7c\x71\xd2\x1a\x5d\xb7\xde\xba\xc9\xe2\x90\xe4\x81\x16\xc7\x0e\x11\x89\x54\x49\xca\x89\x6b\xf8\xbb\x1f\xa8\x7f\x26\x25\xca\x76\xd2\x66\x37\x7b\xbb\x7e\x09\x22\xcd\x0c\x87\xbf\xf9\xcb\xa1\x10\x42\x68\xf5\x1c\xa5\x3f\x0f\xc7\xf4\x1c\x84\xa4\x9c\x79\x6f\x90\x77\xb1\xc0\x82\xe2\x69\x08\xf2\xc0\xdf\xbc\x19\xc0\x0c\x27\xa1\xf2\x5b\x57\x5e\xbb\xe0\x0b\x78\xbc\xf4\xde\x94\x72\xd2\x27\x09\x53\xa9\x10\x99\x4c\x0f\x0c\x41\xab\x55\xf7\x03\x8e\x60\xbd\x3e\xd6\x14\x7e\xab\x8d\x5c\x2f\x3f\xce\x66\x12\x94\xdf\x32\x16\x41\xc8\x63\x38\x02\x2d\x33\xe4\x3c\xf6\xf2\xc7\xeb\x52\x09\x02\x31\x30\x22\x3f\x6a\xdd\x2f\x9e\xaf\x56\x74\x86\xba\x43\x79\x9c\x48\xc5\xa3\xf3\x0f\x27\x9f\xd7\xeb\x82\xd2\xdc\x18\x93\xf3\xe1\x40\x6f\xe6\xf9\x6a\x05\xa1\x04\x37\xd5\x82\x81\xda\x90\x31\x52\x52\x5d\x95\xcb\x87\x3c\xc0\xca\x81\x5c\xf1\xdc\x02\xac\xd8\xc9\x45\xc0\x59\x80\x95\x13\xa0\xf3\x91\xfe\x3b\x16\x30\xa3\x77\x1a\x27\x9f\xd1\xa0\xe3\xb7\x91\x06\x7b\xc8\x08\xdc\x39\xb9\x4a\xe4\xcc\xe5\x62\xc1\x63\x10\x8a\x82\x4c\xad\xe4\xc4\xe6\x59\xa6\x18\xa8\x5b\x2e\x6e\x26\x10\x24\x82\xaa\xe5\x5b\xc1\x93\x38\xe5\x79\x96\xbd\xa7\xa4\xba\xbf\x0d\x80\xcf\x72\x7b\xd8\x08\x69\xa6\xf8\x98\xb3\x19\x9d\x27\x22\x45\x42\xa6\x06\x42\xe5\x6f\xb5\x12\x98\xcd\x01\xbd\x90\xf0\x05\xbd\xf9\x09\x69\xf3\xa2\x23\xd4\x1d\x8e\xfb\x84\x08\x90\x32\x75\x15\x43\x20\x32\x3c\xcd\x82\x93\xc6\x41\xba\xd0\x6a\xa5\x65\xad\xd7\x86\xf3\xb8\x70\x40\xd6\x2f\x45\x05\xbe\x64\x6a\x1c\x59\xcb\xe5\xcc\x34\xc2\x42\xfb\xb9\x12\x09\xb4\x6b\xdc\xf6\xa6\x37\x4c\x0b\xac\x60\x38\xee\x87\x85\x23\x8c\x40\x5d\xf3\x14\xc6\xc1\x92\xe1\x88\x06\x5e\x55\x96\x27\x93\x29\x03\xe5\xd0\xd1\x69\x81\xd5\xea\x45\xe1\x32\x0c\xd4\x24\xe5\xcd\x4d\x62\xb1\xda\xca\x99\xff\x59\xd0\x6a\x18\x42\x95\xc1\xf0\xa2\x66\x84\x76\x7d\xa3\xd5\x27\x57\x99\x83\x31\xae\xd0\x50\x6a\xef\x1a\x32\x05\x73\x81\x15\x98\x54\x46\x5c\x03\xd3\x3b\x19\x8e\x4f\xb9\xb8\xc5\x82\x50\x36\xcf\x41\xae\xb8\xd2\x26\xd6\xd5\x32\x4e\x0d\x3e\xa2\x81\xe0\x92\xcf\x54\xf7\x43\xe6\xb8\x87\xb9\x03\xeb\x25\xc5\x0c\x07\x20\x33\x10\x52\xb7\xcc\xbc\x7e\x84\x19\x9e\x03\x19\x50\x79\x23\x33\xd1\xab\x6f\x8b\x61\x57\x18\xf6\x17\x98\x86\x78\x4a\x43\xaa\x96\x13\xb0\xb3\xe5\x3e\x59\x76\xa2\xb8\xc0\x73\x30\x75\xf5\x9b\x22\xba\x04\xb2\x12\x15\x71\x88\xd5\x8c\x8b\xe8\x54\xe7\xeb\x01\x8f\x30\x65\xc7\x45\x5a\x7e\x59\x0b\x8d\x9c\xf8\x2c\x26\x58\x41\x85\xfa\x95\xd7\xce\x13\x40\x4a\x1b\x65\x5a\x79\xe8\x0d\xf2\xb4\x99\x36\x7e\xb6\x6e\x3f\x6f\x36\xd1\x31\x8f\xe2\x44\xc1\x21\xb6\xb1\x31\x2d\xa4\x33\x30\xca\xcc\x94\x23\xd0\x0f\x02\x23\xfa\xef\x55\xa9\x72\x09\x7b\x57\x2a\x97\x1d\x6d\x2d\x64\x5e\xb4\xf6\xaf\x4a\x46\x24\xbc\xe3\x52\x01\x19\x61\xa9\x40\x6c\xaa\x8c\x5d\xb5\x4a\xa1\x45\x61\xf0\xeb\x1e\x1e\x27\xd3\x90\x06\x65\x5c\x82\x3c\xf4\xad\x22\x1a\xa5\x2b\x8c\x6d\x2a\xbd\x9b\xb4\x9c\x56\xeb\x96\x1d\x61\xdf\xad\x8a\x49\x0b\xb7\xac\x88\x81\xf4\x5b\x17\x11\x27\x07\x98\x90\x83\x4d\x15\x6b\xb5\x77\x03\x5f\x56\xb5\xf6\xce\x35\x72\x13\xb5\xae\x76\x93\xfa\xad\x0b\x42\x17\x7f\x80\x3a\x9b\x34\x91\x11\x97\xd6\x69\xaa\xd8\xa5\x5f\xe0\x8c\xe1\x73\x1e\x5c\x56\x8f\x12\x4d\xe8\x57\x90\x23\x1c\xfb\x2d\xa7\x2f\x9f\x8f\x34\x81\xdf\xba\xea\xda\xaa\x6a\x61\x57\xf5\x7e\xaa\x1e\xc0\x39\x08\x87\x36\xfb\x26\x7e\x4b\x87\xef\xbe\xc3\xd2\xc8\xaf\x4f\x3a\x6c\x09\x56\x98\x50\x79\xf3\xcb\xdf\xe1\xfb\xa0\xf0\x35\xb8\x34\x94\x13\x07\xe7\x04\x80\x54\x82\xe5\x8f\x8f\xf3\x27\xa5\x77\x29\x76\x80\x15\xfe\x7f\x4c\x0a\x1b\x2f\x7d\x82\xcd\x96\xeb\x48\x6b\x63\xbd\x0d\x80\x7d\xda\x9a\xda\xee\x9b\x9b\xd1\x7b\x27\xca\xa6\x1e\xb1\x72\x9e\x7d\x38\x16\x95\x1c\xf3\x3b\x1f\xf6\x17\x91\xce\xcc\x1f\x38\x81\x7d\x8f\xfc\xce\xce\xb1\x29\x2d\x37\xb8\xef\xa1\xbf\x57\x5a\x29\xd2\xa1\x4e\x2f\x7b\x9e\xcb\xdb\x56\xae\xbf\x3b\x1f\xc9\x31\x08\x5b\xe5\x27\x92\x2b\xff\x8c\x9b\xda\xda\x59\x39\x7b\x93\xc7\x75\x8c\x27\x85\xe3\x3d\xea\xdc\xf7\xf4\xa3\xbf\x00\x06\x3b\xeb\x77\x91\x43\x6b\xb3\xb1\x2d\x9d\x62\x6d\x94\x51\xe9\x14\x1f\x61\x52\xe8\x56\xa8\xa9\xc2\x35\xe9\x53\xab\xc7\x8e\xc6\xd5\x53\x78\xbe\x99\x5d\x98\xc5\x44\x40\x5a\xfe\x27\x3c\x11\x01\xa4\x33\x06\x47\x83\x3a\x07\x06\x02\x2b\x2e\x8e\x39\x81\x74\xcf\x9d\x3d\xc1\x79\x10\x28\x02\x64\xaa\x8e\x26\x9a\x24\xb3\x19\xbd\xcb\x14\x33\x44\xdc\x52\xf6\xc9\xa0\x2a\x16\xb4\xc4\x70\x11\x5c\x83\x54\xa9\xe2\x35\x01\xe6\x4b\x2d\x21\x2f\xc8\x9f\xf1\xbc\x22\x25\xe6\x3c\xd4\x04\xa9\x84\x52\xf3\x7a\x75\x7c\xf4\x89\xf4\xfd\xa1\x4c\x33\xf0\x99\x2c\x7a\x96\x21\x01\xa6\xa8\x5a\x6e\x9a\x0b\x9a\x3f\xb1\x1b\x8c\xa2\xe9\x92\x4b\xa9\x20\xea\x4b\x49\xe7\x0c\x88\xe3\xb4\x66\x35\x2a\x8d\xed\xb2\xed\x9e\x95\x31\x6b\x31\x60\x2d\x4c\x3e\x24\xfb\x84\x82\xef\x2e\x42\xcd\x81\x60\xa8\x8d\x90\x77\x8d\x05\xb9\xc5\x02\xc6\x82\xcf\x68\x08\x55\x95\xb2\x2e\xbe\xb1\xff\x2d\x7b\x78\xb7\xf0\x3c\x8f\x34\xc8\xae\x0f\x4c\xed\xe9\x7c\x75\xac\xb8\x0b\xa1\xc6\xec\xe5\xb7\x1f\xf1\xb2\xa3\x3a\xec\x36\xe7\xd3\x57\x4e\x54\xb8\x6c\x00\x24\xc8\x4c\x2c\x7e\x9f\x88\xc8\xfc\xf6\x2d\xa8\xf7\xc9\x14\x04\x03\x05\xf2\x37\xca\x08\xbf\x95\xfd\x39\x30\x95\x5d\xd1\xe8\xaa\x82\xba\xd6\xd0\xdd\xc3\x24\xa2\xec\x4c\x1a\x7a\xda\xc9\x28\x15\x61\xd2\xf8\x95\x55\x33\x09\x63\x2c\xe5\x2d\x17\xb5\x4b\x05\x53\x42\x41\xd3\xe8\x61\x79\xa5\x74\x03\x9a\xee\x4e\xef\x20\xed\xba\xaa\xdb\xa0\x11\x9e\xc3\x27\x98\x81\x00\x16\x54\x59\xb5\x99\x66\x33\x10\xb5\xe3\x8a\x86\x26\x87\xe9\xa3\x26\xa8\xee\x4d\x47\x7f\x32\x0d\xa9\xbc\xde\xce\x3c\x2e\x88\x1c\x02\xe4\x4d\xb2\x8d\x75\x72\x93\x38\x98\x16\x0d\xe7\x2b\x83\x31\xcf\xeb\x95\xfb\x99\xb5\x65\x1a\x9e\xb6\xa8\x75\x34\xd2\xe2\x08\x1f\xe3\x22\xad\x9f\x0a\x1e\x0d\x35\x82\xb6\xf7\xb7\xbd\x00\x07\xd7\xd9\x3d\x8a\xf7\x09\x30\xf9\x4d\x50\x05\xde\xee\x13\x52\xc6\xfc\x88\x9e\xdf\xf6\x3b\x5c\x12\x2a\x6f\x6a\xd7\x53\x6d\x6f\x71\x4d\x1c\x57\x5e\x5e\x22\xa8\xa9\x8c\x28\x7c\xe5\xe0\xef\xc3\xdc\x5f\xf3\x30\xd7\xde\x3e\x36\x6e\x75\xf3\x9b\xda\x13\x46\x62\x4e\x99\x92\xdd\x69\xc8\xa7\x6d\x3f\x73\xbc\x7d\x9b\xe8\x7d\xc1\x42\x85\x47\x77\x17\xd7\xa4\xe6\xd5\x9b\x8e\x3f\x1b\x25\x03\xea\x7e\x9c\xe8\xd8\xd6\x15\xfb\xed\x7f\x50\xaf\x16\x7c\xa4\x7c\xa9\x83\x61\x65\x91\x3b\x0e\x10\x66\xa9\xdb\xe7\x9e\xb4\x68\x60\x16\x54\xa8\x04\x87\xa3\x34\x4f\x80\x63\x86\xdf\xd8\xa5\x35\x0c\xf3\x5f\xf6\x8e\x7e\xec\x1c\xf5\x3a\xbd\xa3\x4e\x2c\x60\x41\xe1\x76\xcb\xf8\x7e\xd7\xfc\xde\x31\xb0\xdf\x36\x11\x32\x76\x5c\xe6\xae\x79\x42\x89\x23\x45\x34\xec\xff\xfe\x5e\xd1\x6a\xfb\x8b\xa8\x68\x57\xed\x39\x6d\x1d\xf5\x7e\xa2\xae\xb9\xa0\x5f\xd3\xbe\xfb\x50\xf0\x10\xb2\x26\x36\x02\xa6\xe4\xee\x01\xaf\x66\x18\xc0\x8c\x32\xaa\xf9\x87\xb5\x62\x2d\x00\x13\x10\x9f\x2a\x54\xd5\x63\x83\xa0\x2c\xa0\x31\x0e\x87\x45\xfb\xd6\x9c\x48\xbf\x1f\x4a\x3a\x40\x5e\xf6\x8e\xfe\xd9\xe9\xbd\xea\xbc\xea\xf9\x6d\xe4\x9f\x26\x61\xe8\xb7\xba\x05\x74\x5d\x43\xaf\xcd\xc0\xf9\xb9\x65\x55\xc7\x51\x60\xb7\xae\x70\xa7\x80\xc9\xf4\x7b\x13\xc7\x58\xf1\x61\xb5\x4d\xef\xe6\xb0\x12\x16\x27\xc5\x3a\x15\xbc\xeb\x4e\xff\x10\xaf\xdf\xea\xf6\x56\x13\x56\x0b\xc7\xd7\x9d\xde\x6b\x57\x38\x56\x8f\x86\x45\x1f\x9f\x7e\xec\x73\xd0\xea\x16\x2f\xad\xdd\xb8\x2f\xba\xb6\xcf\x0b\xbe\x83\xf7\x54\xb0\x70\x2c\xb6\x35\xb4\xf4\x92\x8f\x9f\x08\x90\x9d\x09\xcc\x1a\x70\x65\x05\x60\xc3\xd7\x47\x76\xaf\xba\x51\xb1\xe2\x68\x36\x14\x65\x20\x34\x78\xe3\x29\x17\x79\xc3\x59\xe7\x7b\x87\x19\x09\x41\x18\xee\x72\xd4\xed\x55\x0e\x07\x89\xe2\x67\xf1\x5c\x60\x02\x23\xca\xb8\x41\x5b\xfd\xfa\xc9\x93\xa0\x14\x65\xf3\xfa\x37\x55\x5e\xcc\x85\xf6\xf6\xd7\xbd\x57\x3f\xbe\x6a\xec\x75\x63\xc1\x15\x04\x0a\xc8\xc4\x90\xd3\x5c\xd4\x9c\x77\x49\x7f\x92\x0f\x17\xf7\xbf\xcb\x28\x58\x77\x0d\x4c\x1e\x3b\x00\x75\xbe\xdb\x24\xd2\xad\xa9\xcf\xd0\xb9\x7a\xf1\xf4\x98\x2a\xee\x3a\x68\x9b\x6a\x7d\xfb\x87\x9b\xdf\x54\x7e\x1e\xf5\x60\x85\xfc\xc3\x40\xc2\x96\x3b\x4c\x23\x11\xb9\xd3\x4d\xbe\x13\xd3\x71\x8b\xed\x66\x63\x88\x49\x20\x68\xac\x4a\xa3\x57\x09\x5d\x59\xe5\x5f\x26\xd1\xbe\x39\xc5\xca\x28\x6b\x3b\x83\xd6\x53\x45\x65\x76\x13\x61\x46\x3e\xf3\x93\x3b\x08\xf4\x5e\x0c\xb0\xfd\x98\xdf\x82\x90\xd7\x10\x86\x5d\xb8\x03\xd4\xc9\x68\x28\x67\x63\x1e\xd2\x60\x89\xce\x98\x00\xa9\x04\xd5\x0b\xa0\x4e\x2e\x0a\x5d\x7a\xba\x69\x79\x81\xc5\x3c\x49\x4b\x0a\xfa\x09\xd9\x3e\x2a\x29\x9b\x87\xf0\x6b\xc2\x15\xf8\xfa\x7c\x9b\x7d\x22\x32\x1c\x23\xeb\xd4\x79\x53\x8e\x77\xfa\xe3\xe1\x04\xc4\x42\x93\x68\x7a\xd4\x79\x9f\x4c\x61\xc0\xa4\x7e\x48\x03\x18\xc6\x75\x46\xf3\x6d\xc6\x93\x2d\x72\xfa\xeb\xe0\x43\xe6\x29\x36\x4f\xf6\x0d\xc9\xe9\x17\xc2\x4a\x3f\xf2\x51\xe7\x97\xdc\xa1\x6d\xda\x8d\x9b\x6b\x9a\x74\xe8\xf4\x1e\x96\x36\x4d\x10\x52\xd0\x67\xb5\xf4\x5b\xd6\xf7\xb0\xcc\x69\xbf\x26\x02\xde\x71\xa9\xb4\x5b\x23\xf7\x04\xb4\xe2\xcd\x7b\x4f\x09\x50\xa7\xdf\x1f\x1c\xa7\xcb\x0e\x89\x2d\x5b\x66\x48\x8c\x8b\xb6\xb1\xa0\xf2\x6d\xb6\x09\x04\x02\xd4\x3e\xac\x19\xa5\x6f\x9f\x52\x2d\xa3\x22\x1f\xfd\xbb\x62\xf5\x7c\x3e\x66\x06\x46\x76\x31\x90\x92\x5f\x7a\xe8\x67\xf4\xc3\xe4\xbf\x93\xcf\x27\xa3\xc1\xa7\xe1\xf9\xc9\x0f\x97\x97\x29\x5c\x03\xac\xf0\xe5\xe5\x66\xaa\x37\x01\x95\xc4\x19\x7b\x37\xe4\x73\xf4\xf2\xe7\x7f\x1c\xd9\x63\x36\xbb\x23\xfe\x5f\x00\x00\x00\xff\xff\xf9\x8e\x6e\xd5\xaf\x2f\x00\x00")
+var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xed\x6f\xdb\x36\x13\xff\xdc\xfe\x15\x84\xd0\x4d\x09\x60\x3b\x49\xb3\x62\xc3\x1e\x6c\x40\x16\x27\xad\xb1\x39\xf1\xe2\x34\xc3\xd0\xe4\x83\x22\x9d\x1d\x22\xb2\xa8\x91\x92\x93\xd4\xc8\xff\xbe\x23\xf5\x46\x4a\x94\xed\xb4\xf5\x96\x3d\x9b\x3f\xb4\x88\x74\x77\x3c\xde\xfd\xee\x85\x47\x11\x42\xc8\xe2\x25\x51\x3f\xc7\x8b\xe9\x05\x70\x41\x59\xe4\x7c\x4f\x9c\x0f\x73\x8f\x53\xef\x3a\x04\xb1\xe5\x56\x6f\xfa\x30\xf1\xd2\x30\x71\xb7\xaf\x9c\x4e\xc1\xe7\xb3\xf8\x01\x39\x0a\x39\xea\x49\x1a\x25\x4a\x88\x48\xaf\xb7\x34\x41\x8b\x45\xef\xc4\x9b\xc1\xe3\xe3\xa1\xa4\x70\xb7\x3b\xc4\xf6\xf2\x74\x32\x11\x80\x6f\xb5\x45\x50\x68\x84\xef\xa4\xcc\x90\xb1\xd8\xc9\x1f\x3f\x96\x4a\x04\x10\x43\x14\x88\x53\xa9\xfb\x87\x97\x8b\x05\x9d\x90\xde\x40\x1c\xa6\x22\x61\xb3\x8b\x93\xa3\xf3\xc7\xc7\x82\x52\xdf\x58\x24\xa6\x83\xbe\xdc\x0c\x72\x40\x28\xc0\x4e\x35\x8f\x20\xa9\xc8\xa2\xa0\xa4\xba\x2a\x97\x0f\x99\xef\x25\x16\xcb\x15\xcf\x0d\x83\x15\x3b\xf9\xe0\xb3\x08\x5f\x5b\x0d\x74\x31\x94\xff\x8f\x38\x4c\xe8\xbd\xb4\x93\x1b\x51\xbf\xeb\x76\x88\x34\xf6\x20\x0a\xe0\xde\xca\x55\x5a\x4e\x5f\x2e\xe6\x2c\x06\x9e\x50\x10\xca\x4b\x56\xdb\xbc\xc8\x14\x83\xe4\x8e\xf1\xdb\x31\xf8\x29\xa7\xc9\xc3\x5b\xce\xd2\x58\xf1\xbc\xc8\xde\xd3\xa0\xbe\xbf\xca\x80\x2f\x72\x7f\x98\x16\x92\x4c\xf1\x21\x8b\x26\x74\x9a\x72\x65\x09\xa1\x1c\x44\xca\xdf\x62\xc1\xbd\x68\x0a\xe4\x95\x80\x3f\xc8\xf7\x3f\x10\xe9\x5e\xb2\x87\x0a\x8e\x0e\x82\x80\x83\x10\x0a\x2a\x9a\x40\xa2\x21\xcd\x30\x27\x8d\x7d\xb5\xd0\x62\x21\x65\x3d\x3e\x6a\xe0\xb1\xd9\x81\x18\x3f\x65\x15\xd4\x40\xa9\xb1\x67\x2c\x97\x33\xd3\x99\xc7\x25\xce\x13\x9e\x42\xa7\xc1\x6d\x6e\xba\x62\x9a\x7b\x09\xe0\x56\xc2\x02\x08\x43\x48\x6e\x98\x32\x63\xff\x01\x15\xa7\xbe\x53\x97\xe5\x60\xcc\xa0\x23\x2c\x3a\x5a\x3d\x80\xbb\x2d\x20\x83\x5c\x63\xc5\x9b\xbb\xc4\x60\x35\x95\xd3\xff\x32\x4c\x2b\xcd\x10\x26\x99\x19\x5e\x35\x9c\xd0\x69\x6e\xb4\xfe\xe4\x2a\x03\x58\xc4\x12\x32\x10\x12\x5d\x83\x28\x81\x29\xfa\x1e\x74\x2a\x2d\xae\x21\x92\x3b\x19\x8c\x8e\x19\xbf\xf3\x78\x40\xa3\x69\x6e\xe4\x1a\x94\xaa\x58\x4f\x1e\x62\xe5\xf0\x21\xf5\x39\x13\x6c\x92\xf4\x4e\x32\xe0\xee\xe4\x00\x96\x4b\xf2\x89\xe7\xa3\xa7\x5f\xe6\xac\x05\xea\x87\x5e\xe4\x4d\x21\xe8\x53\x71\x2b\x32\xd1\x8b\xcf\x8b\x61\x5b\x18\x1e\xcc\x3d\x1a\x7a\xd7\x34\xc4\x20\x1a\x83\x99\x2d\xd7\xc9\xb2\xe3\x84\x71\xd4\x52\xd7\xd5\x6d\x8b\xe8\xd2\x90\xb5\xa8\x88\x43\x2f\x99\x30\x3e\x3b\x96\xf9\xba\xcf\x66\x1e\x8d\x0e\x8b\xb4\xfc\xba\x11\x1a\x39\xf1\xfb\x38\x40\x47\xd5\xa8\xf7\x91\x3a\x4b\x00\x8a\x76\x96\x69\xe5\x10\x7c\x25\xdd\x54\xe1\x0c\xcd\xdc\xee\xa2\x43\x36\x8b\xd3\x04\x76\x3c\xd3\x36\xba\x87\x64\x06\x26\x99\x9b\x72\x0b\x1c\xf8\xbe\x16\xfd\x4f\xaa\x54\xb9\x84\xb5\x2b\x95\xcd\x8f\xa6\x16\x22\x2f\x5a\xeb\x57\xa5\x32\x12\xb6\x18\xc7\x68\x78\xc7\x04\x46\xc1\xd0\xc3\x7f\x39\x79\xe7\x89\xa3\x7b\x2a\x12\xc4\x7b\xf6\x64\x94\x5e\x87\xd4\x1f\x8c\xb6\xab\x22\x64\x16\xb5\x72\xcd\xa2\x6e\xb8\xcd\x00\x88\x73\x21\x79\xd8\x82\xd8\x71\x8d\x1a\x3b\x33\x96\xca\xa9\xe4\x66\x55\xb5\xad\x97\x35\x33\x00\xbf\x58\x91\x13\x86\x59\xb3\x1a\x07\x88\xf0\x0f\x33\x16\x6c\x79\x41\xb0\x55\x15\xb9\xed\xce\x6a\xbf\x94\x45\xaf\xb3\x72\x8d\xdc\x83\xdb\x57\xab\x49\x51\x9d\x80\xce\xff\x06\x75\xaa\x2c\x92\x11\x97\xde\x69\x2b\xe8\x25\x2e\xbc\x8c\xe1\x3c\x8f\x3d\xa3\x85\x99\x8d\xe9\x47\xc0\xec\x17\xe3\xbe\xec\xfd\x86\x24\x40\x0f\xf6\x4c\x55\xa5\xb0\xab\x66\xbb\xd5\x8c\xef\xdc\x08\x3b\x26\x7b\x15\xde\x65\x3c\xf4\x10\xf9\x5a\xfa\x7d\xd6\x51\x8d\xd9\xd0\x0b\x50\xd7\x5f\xfe\x8b\xee\x4d\x44\xb7\xc6\x25\x2d\x3d\xb6\x70\x8e\x01\x82\x5a\x2c\xfd\xfd\x69\xe0\x59\xe9\x5d\x8a\xed\xa3\x2a\xff\x8f\x39\xa3\x42\xe9\x33\x6c\xd5\x6c\x07\x62\xd3\xd6\xcb\x0c\xb0\x4e\x53\xd4\xd8\x7d\x7b\x2b\xfb\xe4\x3c\xda\xd6\x61\xd6\x4e\xc3\x9f\x6e\x8b\x5a\x8e\xf9\x8b\x47\x05\xf3\x99\x4c\xdc\x27\x2c\x80\x75\x07\x06\xd6\xbe\xb3\x2d\x2d\xb7\xc0\x17\x93\xf2\x53\xd2\xa1\x4c\x2f\x6b\x9e\xea\x3b\x46\xae\xbf\xbf\x18\x8a\x11\x70\x53\xe5\x67\x92\x2b\xff\x89\x9b\x5a\xda\x78\x59\x5b\x97\xcd\x02\xe3\x59\xd9\xf1\x09\x75\xee\x4b\xe2\xe8\x5f\x60\x83\x95\xf5\xbb\xc8\xa1\x8d\xc9\xda\x92\x4e\xb1\x31\x08\xa9\x75\x8a\x1b\x98\x33\xda\x15\x6a\xab\x70\x6d\xfa\x34\xea\xb1\xa5\x71\x75\x12\x6f\x5a\x4d\x3e\xf4\x62\xc2\x41\x95\xff\x31\x4b\xb9\x0f\x6a\x42\x61\x69\x50\xa7\x10\x01\xf7\xd0\x41\x87\x58\x18\xd4\x9e\xbb\x6b\x1a\xe7\x93\x8c\x82\x3d\xb8\x52\x47\x12\x8d\xd3\x09\x8a\xca\x14\xd3\x44\xdc\xd1\xe8\x4c\xa3\x2a\x16\x34\xc4\x30\xee\xdf\x80\x48\x94\xe2\x4a\xc0\x62\xf1\x16\x92\x03\xdc\x4c\x72\xaa\xbd\x92\xfc\x79\x39\x3e\xf7\xa6\xa4\x67\x8c\x41\x9d\x98\xb1\x50\x52\xe4\x02\x72\xc5\x9b\xc5\x71\xe3\xe3\xec\xa7\x5b\x52\x25\xe0\xf7\xa2\x68\x59\x06\x01\x6e\x1c\x31\x52\xf5\x16\x34\x7f\x62\xf6\x17\x45\xcf\x25\x1e\xf0\x68\x34\x3b\x10\x82\x4e\x23\x08\x2c\x67\x39\xa3\x4f\x69\xed\x96\x4d\x74\xd6\x66\xb4\xc5\x74\xb6\xf0\xf8\x20\x58\x27\x12\x5c\x7b\x0d\x6a\x8f\x03\x4d\x6d\x5c\xf3\xc6\xe3\xc1\x9d\xc7\xd1\xb6\x6c\x42\x43\xa8\xab\x94\x35\xf1\xad\xed\x6f\xd9\xc2\xdb\x85\xe7\x69\xa4\x45\x76\x73\xda\x6a\x8e\xf6\xeb\x33\xc9\x55\x16\x6a\x4d\x5e\x6e\x67\x83\x37\x25\xf5\x49\xb9\x3e\xdc\xbe\xb2\x5a\x85\x89\x16\x83\xf8\x99\x8b\xf9\x5f\x13\x11\x19\x6e\x31\x09\xfc\x9c\x5e\xe3\x9a\x90\x80\xf8\x8d\x46\x01\xbb\x13\x2a\x2b\x64\xf7\x3b\xb2\xa8\xc8\x2c\xa0\xab\xe9\x05\x33\x1a\x61\x28\x55\x7a\x9a\xb9\x48\x89\xd0\x69\xdc\xda\xaa\x99\x84\x91\x27\x04\xfa\xa9\x71\x23\xa1\x4b\x28\x68\x5a\x11\x96\x17\x4a\xbb\x41\xd5\xee\xe4\x0e\x54\xd3\x55\xdf\x06\x9d\x21\xe3\x19\x4c\x80\x43\xe4\xd7\x59\xa5\x9b\x26\xf8\xaa\x71\x5a\x91\xa6\xc9\xcd\x74\x2a\x09\xea\x7b\x93\xd1\x2f\x07\x28\xe2\x66\x39\xf3\xa8\x20\xb2\x08\x10\xb7\xe9\x32\xd6\xf1\x6d\x6a\x61\x9a\xb7\x1c\xaf\x34\xc6\x3c\xb1\xd7\x2e\x77\x1e\x0d\xd7\x30\xd5\xa1\x36\xad\xa1\x6a\x23\x9c\xc6\x45\x5a\x3f\xe6\x6c\x36\x90\x16\x34\xd1\xdf\x71\x7c\xcf\xbf\xc9\x2e\x61\x9c\x33\xf0\x82\xdf\x38\x4d\xc0\x59\x7d\x40\xca\x98\x37\x88\xfc\x8e\xdb\x65\x42\x0e\xe3\x1a\x77\x5b\x1d\x67\x7e\x13\x58\xee\xcb\x9c\x94\x53\x5d\x19\x5e\x60\x65\xeb\xbf\xb3\xdc\xbf\xf3\x2c\xa7\x93\x58\x86\xca\xdb\xbd\xfc\x9a\xf7\x28\x0a\x62\x46\xd1\xff\xbd\xeb\x90\x5d\x77\xdc\x0c\x78\xeb\xf6\xd0\xeb\x1a\x8b\x14\x88\xee\x21\x7e\x1b\xa8\xae\x1a\xfe\x6c\xd0\x0c\xa4\x77\x3a\x96\xb1\x2d\x2b\xf6\xdb\x9f\xc8\x6e\x23\xf8\x82\xf2\xa5\x0c\x86\x85\x41\x6e\x39\x3f\xe8\xa5\x6e\x9d\x4b\xd6\xa2\x81\x99\x53\x9e\xa4\x5e\x38\x54\x79\x02\x2c\x13\xfe\xd6\x2e\xad\x65\xd4\xff\x7a\x77\xef\x9b\xee\xde\x6e\x77\x77\xaf\x1b\x73\x98\x53\xb8\x5b\x32\xdc\x5f\x35\xdd\xb7\x8c\xf3\x97\x0d\x84\xb4\x1d\x97\xb9\x6b\x9a\xd2\xc0\x92\x22\x5a\xf6\xff\x74\x54\x60\x2a\x9b\xcf\x8a\x76\xd5\x1c\xd3\x36\xad\x7e\x90\x26\x37\x8c\xd3\x8f\xaa\xef\xde\xe1\x2c\x84\xac\x89\x9d\x81\x9c\x94\xae\x9c\xef\x4a\x86\x3e\xaa\x10\x51\xc9\x3f\x68\x14\x6b\xac\x0a\x01\xf0\xb3\x1a\x55\xed\xec\x81\x51\x11\xf9\x34\xf6\xc2\x41\xd1\xbe\xb5\x27\xd2\x2f\x67\x25\x19\x20\x08\x8d\x6f\xbb\xbb\xfb\xdd\xfd\x5d\xe4\x77\x8f\xd3\x30\x74\xb7\x7b\x85\xe9\x7a\x9a\x5e\xd5\xbc\xf9\xa5\xe1\x55\xcb\x51\x60\xb5\xae\x70\x9f\x40\x24\xd4\xc7\x2a\x96\xa9\xe2\xa7\xd5\x36\xb9\x9b\x9d\x5a\x58\x1c\x15\xeb\xd4\xec\xdd\x04\xfd\xa7\xa0\x7e\x29\xec\x8d\x26\xac\x11\x8e\x6f\xba\xbb\x6f\x6c\xe1\x58\x3f\x1a\x16\x7d\xbc\xfa\x52\x68\x6b\xbb\x57\xbc\x34\x76\x63\xbf\xe7\x5a\x3e\x2e\xf8\x02\xe8\xa9\xd9\xc2\xb2\xd8\xd2\xd0\x92\x4b\x6e\x3e\x11\x10\x33\x13\xe8\x35\xe0\xca\x08\xc0\x96\x4f\x97\xcc\x5e\xb5\x52\xb1\x06\x34\xd3\x14\x65\x20\xb4\xa0\xf1\x98\xf1\xbc\xe1\x6c\xf2\xbd\xf3\xa2\x20\x04\xae\xc1\x65\xaf\xb7\x5b\x3b\x1c\xa4\x09\x7b\x1f\x4f\x39\x26\x96\x21\x8d\x98\x46\x5b\xff\x74\xca\xc1\x42\x28\xaf\x48\x9b\x1f\x64\x39\x31\xe3\x12\xed\x6f\x76\xf7\xbf\xd9\x6f\xed\x75\xd1\x2c\x09\xf8\x09\x04\x63\x4d\x4e\x7b\x51\xb3\x5e\x25\xfd\x43\xbe\x7a\x5c\xff\x2a\xa3\x60\x5d\x35\x30\xd9\x74\x00\xca\x7c\x57\x25\xd2\xa5\xa9\x4f\xd3\xb9\x7e\xef\xb4\x49\x15\x57\x1d\xb4\x75\xb5\x8c\xce\x49\x9d\x4b\xf3\x7b\x7d\xce\xe6\x54\xee\x03\xe1\xd7\x57\x7e\xc0\xba\x88\x71\xfa\xb9\xdf\x88\x7e\x56\xb1\xda\xe8\x31\x0c\xdd\xea\x0b\x58\x72\xe1\xa9\xa5\x2d\x7b\x72\xca\x77\xa2\xc3\xbc\xd8\x6e\x36\xb4\x18\xfb\x9c\xc6\x49\x09\x91\x3a\xa1\x2d\x07\x7d\xa7\x13\xad\x9b\x81\x8c\xfc\xf3\x68\xe6\xdb\x66\x62\xa9\x4d\x7a\x66\xa8\xc5\x39\x3b\xba\x07\x5f\xee\x45\x33\xb6\x1b\xb3\x3b\x5c\xf0\x06\xc2\xb0\x07\xf7\x40\xba\x19\x0d\x2a\x30\x62\x21\xf5\x1f\xc8\xfb\x88\xcb\x71\x2d\x95\x0b\x90\x6e\x2e\x8a\x5c\x3a\xb2\xc5\x79\xe5\xf1\x69\xaa\x0a\x10\xf9\x81\x98\x88\x16\xa8\x48\x08\xbf\xa6\xa8\x9a\x2b\x4f\xc3\x19\x00\x07\x23\x62\x9c\x51\x6f\xcb\x61\xd0\xc1\x68\x30\x06\x3e\x97\x24\x92\x9e\x74\xe5\x9c\xa8\x1f\x09\xf9\x90\xfa\x30\x88\x9b\x8c\xfa\xdb\x8c\x27\x5b\xe4\xf8\xd7\xfe\x49\x86\x14\x93\x27\xfb\xe0\xe4\xf8\x8f\x20\x2a\x71\x84\x3c\xbf\xe4\x80\x36\x69\x2b\x98\x4b\x1a\x35\xa2\xfa\x19\x1e\x4c\x1a\x3f\xa4\x20\x4f\x76\xea\xb3\x59\x7c\x9b\xd3\x7e\x4c\x39\xc8\xcf\x6b\x24\xac\x89\x7d\x5e\x5a\x43\xf3\xda\x33\x05\x94\x7e\xd0\x3f\x54\xcb\x0e\x02\x53\xb6\xc8\x2c\x31\x2a\x9a\xcc\x82\xca\x35\xd9\xc6\xe0\x73\x48\xd6\x61\xcd\x28\x5d\xf3\x4c\x6b\x38\x95\xb8\xe4\x7f\x35\xaf\xe7\xd3\x34\x3d\x30\xb2\x5b\x04\x45\x7e\xe9\x90\x1f\xc9\x57\xe3\xdf\xc7\xe7\x47\xc3\xfe\xd9\xe0\xe2\xe8\xab\xcb\x4b\x65\x2e\x39\x35\xbb\xbc\xac\x66\x80\x08\xe4\x34\xce\xd8\xb1\x4b\x9b\x92\xd7\x3f\x7e\xbd\x67\x0e\xe5\xcc\xfe\xf9\x4f\x5f\xec\x9f\xb4\x1a\x30\x00\x00")
 
 func kuberneteswinagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1257,7 +1257,7 @@ func kuberneteswinagentresourcesvmasT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteswindowssetupPs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x3b\x6d\x73\xdb\x36\x93\xdf\x3d\xe3\xff\xb0\xc3\xf8\x83\x3c\x0d\x64\x3b\x4d\x5f\x46\x73\xba\x7b\x54\xd9\x49\x35\x8d\x65\x3d\x96\x12\xcf\x5d\xfd\x8c\x0d\x93\x2b\x09\x35\x09\xb0\x00\x28\x45\x4d\xf3\xdf\x6f\x00\xf0\x05\xa4\x28\xd9\xc9\xa4\xe9\x74\x12\x12\xfb\xce\xdd\xc5\xee\x02\xfa\xaf\x17\x87\x07\x00\x00\xdd\xe9\xff\x8e\xaf\x26\xd3\xd1\xd4\x3d\x9a\x3f\x13\x29\x56\x4c\x31\xc1\x15\x7c\xb8\x04\xaa\x80\xc2\x6f\xd9\x03\x4a\x8e\x1a\x15\xd0\x05\x72\xdd\x3d\x3c\xc8\xd1\xcf\x2f\xa6\xc3\xeb\xd1\x64\x36\xba\x1a\x7f\x29\x85\x17\xff\x7d\x78\xf0\xfb\x30\x89\x62\xd4\xbf\x30\x1e\x31\xbe\xe8\x9c\xe3\x9c\x66\xb1\x9e\x50\x49\x13\xd4\x28\xa7\xa8\xc7\x34\xc1\x7e\x30\xd5\x94\x47\x54\x46\xc1\xf1\x7f\x0e\x0f\x52\xb3\xdc\x71\xec\x7e\x57\x5a\x32\xbe\xf8\x4f\xfe\xf4\x81\xc6\x2c\xa2\x1a\xc7\x42\x8f\xb3\x38\xbe\x92\x17\x49\xaa\x37\x9d\xe3\x7c\xfd\xe8\x92\x2a\x8d\x72\x34\x79\x59\x28\xf0\x7b\x5a\xf0\x2a\x81\x9e\x24\x62\x74\x39\xe7\x6a\x8a\x72\xc5\x42\x1c\xa5\x6d\xc4\x2e\x8d\xbc\x5a\xc8\x4d\xff\x48\xcb\x0c\x9f\x4d\xdb\x09\xf8\xe6\xdf\xe7\xe3\x89\xc4\x39\xfb\xf8\x2d\x69\xbf\x13\x21\xd5\x4c\xf0\x6f\x49\x73\x60\x3e\xe6\x6f\xb8\xf9\xa6\x34\xff\xca\x24\xfe\x2a\x94\xe6\x34\xc1\x6f\x4a\x78\x70\x3e\x8c\x19\x72\x3d\x8a\xfe\x11\xb2\x53\x0c\x25\xea\xc3\x83\x63\x43\xfc\x68\x11\x8b\x07\x1a\xf7\x86\x83\x21\x4a\xcd\xe6\x2c\xa4\x1a\xa1\x0f\xc1\xa7\x4f\x37\x92\xa6\x03\xf5\x81\x4a\x46\x1f\x62\x84\x20\xa4\x1e\x48\xf0\xf9\x73\x50\x61\x5b\xfb\x3e\x4d\xc0\xb2\xdf\x49\xe4\x5c\x84\x8f\x26\x98\xac\xbf\x9a\x80\x32\x54\xdc\x4b\x0f\xea\xfa\x7a\x30\x6d\xc0\x5c\x63\x22\x34\x0e\xc2\x10\x95\xf2\x20\x6d\x00\x30\x69\x20\xc2\xde\xed\x63\x63\xe5\x17\xc6\xa9\x64\xa8\xa6\x83\xe9\xfb\xeb\x77\xed\x02\x3f\x6e\xc1\xd5\x25\xf6\xe9\x7c\x40\x69\x72\xc9\xd3\x84\x72\xc0\x3a\xa5\x1b\xc6\x23\xb1\x56\x33\x8c\x31\x41\x2d\x37\x6f\xdf\x8f\xce\xdb\x49\xad\x5b\x20\xb7\xa5\x8a\x51\x4f\x35\x95\xfa\x0d\x8b\x8d\x89\x9a\x26\xf9\x0e\x82\xdb\x47\x07\xa6\x0c\x58\x37\x55\x67\x0d\x0a\x13\x29\x3e\x6e\x9e\x43\x23\x35\x80\x6d\x54\xc6\x54\x8f\x51\xaf\x85\x7c\x74\xd9\x91\x53\xed\xad\xce\x24\xe5\x2a\xa5\x12\x79\x1d\x4a\xd7\xde\x07\xbe\x8f\xce\x90\x53\x13\x15\xed\x86\xd1\x6e\xb5\x61\x8c\x69\xf6\xa0\x42\xc9\x52\x93\x51\x76\x61\xaa\x1a\x4c\x1d\xff\x1a\x95\xc8\x64\x88\x6f\xa5\xc8\xd2\x76\x74\xe9\x83\x6c\x71\xe7\x6e\x73\xd8\xc9\x39\x5f\x6f\xe0\x61\x98\x49\xa6\x37\x96\xe4\x6e\x74\xae\x16\xdb\xb8\x1f\xc6\xfb\x38\xae\x98\xd4\x19\x8d\x3d\x93\x37\xf4\x15\x99\xc6\x99\x81\xdd\x4d\x43\xd6\x60\xea\xf8\x13\xc9\x12\x2a\x37\x83\x15\x65\x31\x7d\x60\x31\xd3\x9b\xe9\x3e\x79\xd2\x9d\xf0\x75\xba\x63\xc4\x68\x42\x75\xb8\xbc\x61\x7c\x3c\x98\x19\x7f\x9c\xd3\x58\xa1\xef\x1f\xef\x15\x5e\x52\x4e\x17\x18\x8d\x22\xe4\x9a\xe9\xcd\xc5\x47\x8d\x7c\x77\x58\x66\xbb\x11\xea\xdc\xdf\x2b\x1c\x71\xa5\x29\x0f\xf1\x12\x35\x8d\xa8\xa6\x3b\x29\x36\x01\x1d\xa5\xc3\x83\x39\x8b\x35\x4a\x98\xb1\x04\x95\xa6\x49\x0a\x9f\x82\xa3\xce\x5b\xd4\xe4\xdc\x24\x4c\xf2\x46\xc8\x84\x6a\x10\xc7\x3d\x38\xba\x0b\x3e\x5b\x8c\x8c\x87\xc6\x27\x0f\x0f\x6e\x24\xd3\x48\xde\x89\x45\xe7\x28\x41\xa5\xe8\x02\x8f\x0f\x0f\x3e\xe5\x89\x3d\x51\x0b\x63\x8e\x7c\x01\xfe\xae\x58\x38\x00\x87\x7c\x95\xe9\x34\xd3\x16\xfa\xf0\xa0\x46\x1e\xa6\xa8\x49\x99\x4f\xa6\xa8\xb5\x29\x72\x2a\x06\x66\x79\xa4\x31\x99\x48\x91\xa2\xd4\x1b\x20\x13\xaa\x97\x10\xfc\xfa\xdb\xbb\xcb\xde\xed\x54\xcc\xf5\x9a\x4a\xbc\xbd\x64\xa1\x14\x4a\xcc\xf5\x6d\x9e\xcc\x6e\x87\x99\x34\x61\x9c\x27\xbc\xdb\x89\x88\x59\xc8\x50\xdd\x9e\x53\x4d\x87\x22\x8e\xd1\xb2\x0f\x80\x58\xf7\x08\x86\x22\x49\x50\x86\x8c\xc6\xa3\x28\x00\xf2\x81\xc6\x19\xc2\xde\x04\x69\x8c\x16\x62\x43\x9d\xc3\x83\x8b\x8f\x29\xe5\x11\xf9\xbf\xd1\xc4\xa4\xae\xce\xd1\x9c\xc5\xf8\x12\x8e\x22\x54\x9a\x71\x5b\x5b\x78\xd6\x53\x4b\x8c\x63\xe8\x03\xc7\x35\x11\x0f\x7f\x60\xa8\x81\x84\x22\x01\xfb\xbe\x4b\xd3\x34\x66\xae\x1e\xc9\xe1\xff\x62\x26\x0d\x38\xb4\xae\x11\x7c\x9a\xd2\x30\x67\x72\xec\x60\xe6\x42\x22\x0d\x97\x9d\x23\xa6\x31\x01\xc6\x2d\x52\xd7\x3c\xa8\xce\x71\x0e\xf3\xa9\xaa\x43\x3d\x5a\xca\xd1\xf2\x25\xed\x86\x22\xdd\x2c\x51\xa2\x23\x97\xa3\x7f\xde\x52\xda\x78\x92\xbf\x1f\x79\x1f\xd0\xb0\x9f\xbb\x24\x6e\x77\xc2\xee\x5f\x2c\x0d\xdc\xd2\x88\xaf\xc4\x23\x92\x1b\x7c\xb8\xc6\x3f\x33\x54\x1a\xc8\x7b\xc9\x60\xcf\x46\x69\x1c\xc9\xee\x08\x05\x55\x47\xa8\x6e\x73\x20\x35\x10\x20\xe7\x95\x46\x30\xec\xdd\x6e\x49\x6f\x43\x9b\xb8\xd8\xb6\x0c\x37\xbe\xfc\x6b\xc6\x39\xd5\x61\x26\xe5\x8e\x7d\xc8\x01\x74\xd5\x46\xe5\x7a\xb1\x39\x74\x66\xa8\xb4\x73\x55\x8f\x40\x8b\xf9\x77\x67\x18\x53\x5f\x79\x80\x8e\x8a\xda\x28\x63\xc8\x23\xe4\xab\xde\x74\xa3\x34\x26\xd7\x42\xe8\x5b\xf7\xcf\xef\x5f\xdd\x46\x92\xad\x50\xaa\x6d\x99\x6c\x2c\x69\x91\x92\xbc\x7c\x01\x07\x51\x2d\x6a\xfa\x88\x62\xcd\xe1\x64\xee\xf1\xaa\x96\x59\x48\xc3\x58\xf9\x62\x9c\x2c\x24\xe5\x1a\x82\x41\x94\x30\xce\x94\x96\xa6\x2e\x54\xbd\xce\x9b\xe3\xc0\x20\x54\xa8\x43\x91\x6e\x6c\x0c\xd7\x6c\xd9\xc6\xe4\x21\x8c\x30\x62\x1a\x4e\x14\x6a\x98\x5d\x4c\x67\xd3\xd1\xdb\xf1\x68\xfc\x16\x8a\x00\xd8\xf6\x3c\x97\x5f\x6c\x39\x3c\x14\x7c\xce\xfc\xdc\x71\x44\xab\xd7\x7b\xea\x08\x0b\xd5\xfd\x43\x09\x1e\x14\x15\xaf\x8f\x09\x7d\xf8\x57\x50\xd2\x2c\x76\xfa\x28\xe8\x41\xd0\xac\x0d\x82\x97\x39\x50\x63\x53\xf7\x40\xeb\x25\x41\x89\x40\x69\x54\x94\xdd\x16\xda\x2b\xc3\xb7\x61\x5c\x0d\x5d\x87\xcb\xdf\x15\xb0\xf5\xb2\xc0\xe3\x5f\x2b\x29\x4a\xf0\x38\x6f\x7b\x2c\x64\xd1\x03\xf9\xda\x14\x85\x42\x5d\x93\xe2\x6d\x09\xd8\xac\x1c\x6a\xf0\x5b\x8b\x05\xda\xaa\x85\x7a\x51\x48\x54\x1a\xd5\xf7\x7d\x5f\xa5\xfa\x4a\x81\xb0\x67\x73\xf7\x90\x77\x97\x0c\x25\xa1\x7d\x1b\x75\x0f\x9e\xb1\xff\x7b\x84\xb6\xf6\xe7\x1a\x81\xe6\xaa\xf5\xf6\xe0\x5f\xad\x5e\xf9\x37\x5c\x65\xda\x65\x3a\x82\x3c\x14\x11\xe3\x0b\x18\x4c\x87\xa3\x11\x10\x93\xf6\x52\xbb\x4d\x36\x43\x20\x0f\xcd\xd6\x20\x32\x31\xb1\x1d\x43\x8f\xe5\xdb\x3d\x21\x14\x5a\x80\x2a\x7c\x2a\xa4\x3c\x7a\x08\x21\x87\x07\x34\x65\xf9\x76\xdc\x83\xd5\xd9\xe1\x41\x18\x67\xa6\x93\x57\xbd\xc3\x03\x02\xf9\x43\xcf\x51\x08\xab\x3e\x8d\xd0\x4c\x2f\x85\xf1\x1c\x62\x6c\xe2\x7d\xbb\x5a\xdb\x98\xe7\x39\x85\x72\x85\xb2\x07\x4b\xad\x53\xd5\x3b\x39\x39\xfa\x54\xcc\x33\x3e\xf7\x5e\xbf\xfe\xde\x00\x99\x96\xd9\x50\x69\xce\x11\x82\xc3\x83\x50\x70\x8d\x1f\x75\x2e\x91\x7b\x28\x24\xca\xe5\x6b\x47\x34\x10\x99\x6a\x5f\x26\xd4\x64\xc8\xe0\x29\xd6\xae\x5e\x21\x05\xd7\x76\xa8\x47\xc6\xa3\x1e\x38\xd3\x1e\x1e\x18\x8e\x4e\xd6\x5d\x84\x3d\xde\x56\xbc\x42\x17\x66\x59\x79\x46\x6e\x98\xb6\xd9\x53\x07\x35\xc4\x47\xac\xbe\x45\x31\xdd\x08\x6a\x9e\xea\x39\xc0\x33\x1d\xb5\xee\x67\xed\x7e\x3a\xc6\x35\x19\xf1\xb9\xa4\x43\xc1\x35\x65\x1c\xa5\xe7\xa9\x61\xd4\x74\x4d\xf7\x3e\xb2\x4d\x3c\x3c\x64\x2c\x8e\x80\x68\x78\xcc\x1e\x62\xd4\x6b\xc6\x4f\x52\x9a\x29\x84\xee\xbe\x78\x70\xf3\xb8\xb2\x13\x55\x9d\xa3\x54\x44\xc3\xd1\xf9\xb5\x17\x21\x79\xcb\x3b\x90\x8b\x77\x4c\x69\xe3\xf0\x9d\x80\x90\x65\x3e\x9c\x21\x62\x85\x52\xb2\x08\xfb\xf7\xa5\x71\xfd\xe1\x4d\xf0\x32\x20\x24\x15\x11\x61\x46\x31\xfb\xf9\xad\x66\x84\x25\x74\x81\xfd\x86\xb4\x16\xda\x24\xf7\x78\x65\x40\xe7\xfd\xa0\xf8\x63\x16\x8c\x0d\x5d\x28\xf6\x4d\xa9\x55\x84\xa5\x59\x0a\x63\x91\x45\x24\x95\x62\xc5\x22\x94\x7d\x9b\x16\xbc\x05\x1f\xcb\xdb\x0f\x8f\xeb\x2a\x9a\x22\x99\xf2\xe8\x1d\xe3\x98\xc7\xb5\x45\xc8\x9b\xf9\x2e\x7e\x44\x78\xbe\xe2\xf0\x05\x6a\x43\x53\x69\x20\x84\xc6\xb1\x58\x93\x54\xb2\x15\x8b\x71\x81\x51\xdf\x14\x4c\x40\x08\x72\xb3\x15\x90\x08\x1f\xb2\xc5\x82\xf1\x05\x59\x52\x1e\xc5\x28\x15\x18\x65\x6d\x10\x93\x88\xab\x4a\xaa\xe6\xac\xd2\x87\x13\x09\x65\xbc\x9f\x3f\x76\xcd\x36\x19\x03\xec\x32\xb4\x51\x9e\x32\x99\x32\x4e\x12\x11\x61\x3f\x95\x22\x61\x2a\xcc\x44\xa6\xc8\x83\x64\xd1\xc2\x88\xb7\xea\xbf\x32\xc2\x1b\x4b\x78\x5a\x4b\x5c\x98\x12\x6a\xb3\xe3\x43\x18\xfd\x33\xae\x59\x82\x44\xba\x52\x99\x98\x07\x91\xe9\xfe\xd9\x69\x02\xd0\xfe\x7d\x61\xff\xd7\xf5\x03\xd6\x94\xac\xfb\x06\x4b\x24\xd6\x10\x9c\x75\x7f\xee\x9e\x06\xdb\x45\xec\x0b\xa3\x50\xca\x88\xcb\xbd\x10\x61\x2a\xd1\x24\x0d\x05\x73\x29\x12\xb0\x68\x5e\x25\xdb\x88\x97\xef\xfa\x10\xf8\xf8\xaa\x5f\xe4\xee\xfb\xa3\x4f\xb9\x48\xb5\x1c\x1e\x6c\xd3\xf2\x1d\xd3\xd0\x83\xaf\x21\xf8\xf9\xd9\xc6\x58\xa0\x31\xc6\x8f\x3b\x8c\xa1\xb4\x48\x21\x53\x26\xcd\x95\x1f\x18\xf2\xcf\x07\x8c\x6b\x94\x73\x1a\x62\x61\x9b\x1f\xbb\xa7\xdf\x41\x47\xcc\xe7\xcc\x34\x9f\xf1\xa6\x32\x5f\x54\x80\xfc\xd4\x3d\x3d\xf6\x2a\xf1\xe7\x7d\xaa\x9f\x2a\xe9\x1a\x12\xee\xfe\x08\x79\xe8\x84\x92\xf5\xed\x7c\x23\x68\x47\x6a\xb1\xf6\x1e\xcc\xcf\xbe\x6d\x12\x21\x11\x9c\x21\x14\x70\xc4\x08\x23\xd0\x02\xd2\x2c\x8e\x21\x1f\x2e\xe6\x5b\x38\xd8\x44\xa0\xa0\x33\x8f\xe9\x02\x54\x96\xa6\x42\x7a\x36\xf9\xb1\x66\x93\x3d\x92\x59\x32\xc4\x30\x30\xd1\xb1\x90\xa8\x14\x89\x90\x46\x31\xe3\xd8\x7f\x75\x9a\x98\x20\x59\x98\x92\x54\x91\x14\x25\xf9\x53\x28\xa7\x81\x55\x6a\x6e\x5a\x7c\xc2\x45\x84\x36\xdd\x84\x54\x1b\x35\xfb\xf7\xc1\x7d\x50\x39\x4d\x8b\x3d\xa7\xda\x4e\x7e\xef\x83\x00\xbe\x83\x4e\xd3\xd8\xe4\x0f\xc1\xb8\x59\x7d\x79\x1f\x04\xc7\xa6\x80\xb2\xe4\xf6\x51\xfa\xd7\x7d\x93\xca\x54\xcb\xfb\xe3\x7a\xc5\x65\xb7\x29\x87\x60\x72\xf3\xfd\x53\xd3\xce\xfd\xeb\x3e\x85\x5a\xe2\xee\x07\xf5\xe3\x07\x1f\xb0\x88\xab\x7e\x50\x9e\x23\xf9\xcb\xcd\xa9\x6c\xfb\x7b\x1f\xa3\x99\x9c\xfb\xc1\xd6\xd9\x52\x13\xbc\x11\x10\x15\x97\x96\xc5\x60\x7b\x8e\x31\x11\xd1\x5b\xaa\x71\x4d\x37\x9d\xfb\xed\xdd\x5e\xa2\xce\x24\x87\x72\xa5\x6b\x5a\x3d\x7b\xbe\xd6\x39\x7d\x59\xbd\x8d\xa9\xd2\x23\x1e\xe1\xc7\xab\x79\x27\xe8\x06\xc7\xf6\x2b\x77\xcf\x82\xad\x52\x63\x8a\x9a\xb8\x63\x86\xdc\x00\x6d\x4c\x5f\xc0\xcc\x30\x15\xf3\x39\xbc\x61\x12\xd7\x34\x8e\x4d\xd4\xb8\xa0\x83\x54\x44\xca\x3c\x6a\x1a\x3f\x9a\xbf\x55\xde\xe1\x23\x8f\x52\xc1\xb8\x56\x5d\xe8\xe4\xbe\x03\x6a\x29\xb2\x38\x02\x5c\x21\xd7\x99\x4b\x37\x02\xf4\x92\xa9\x3c\x98\x38\x6a\xb5\x04\x1a\xad\xe6\x05\x1f\xd3\x8a\xd3\x38\x4e\xa5\x30\xc5\x9a\x02\xa5\xa9\x46\x23\x4a\xe1\x7a\xf7\x47\xae\xc2\xaa\x7b\x51\x3f\x2f\xbb\xb8\xd3\x0a\x62\xb3\xfd\xfe\x99\x31\xd4\x40\x48\x3e\x8d\x0c\xc6\x83\xcb\x8b\xfe\x13\x6e\xea\xcd\x54\xda\x39\x75\x63\xe4\x0b\xbd\x04\x82\x7f\xc2\xe9\x76\x3e\xb6\xe6\x7c\x7b\xd3\xdf\xf3\x69\xfd\x04\x15\x4a\x34\xfa\x71\x5c\x83\x77\x18\x50\xa8\x51\x41\x36\xd4\xcb\xd1\x08\x71\x03\x98\xbe\x8f\x4b\x88\x6b\xa0\xfb\x25\x4f\x20\x64\xe1\xe4\xe8\xe7\xe2\xc1\x13\x56\xf0\x85\xf4\x75\x5b\x25\x6a\xcd\x74\xb8\x84\x3e\x2c\x50\x93\x55\x32\x75\x8f\xf0\x37\xfc\x0f\xb8\x7f\xcf\x36\x29\x02\xb9\xf8\x37\x98\x7e\x54\x72\x1a\xb7\xa8\x6b\x6a\x36\x58\x71\x16\xc2\x5c\x48\xc8\x65\x03\x96\x1a\x77\x9a\x0b\xb9\xa6\x32\x02\xbd\x44\x63\x12\xb3\x51\x01\xe5\x11\x94\xc7\x2f\x06\x28\x66\x4a\x23\x07\x53\xf2\xc1\x87\xd1\xa4\x62\x31\x88\x22\xf2\xe1\x32\xd7\x64\x10\xd1\xd4\x7c\x78\xe2\x3a\xe5\x04\xb9\xbe\x9a\xe6\x13\xd8\x9c\x8d\x59\x75\x72\xdb\xb7\x95\x86\xdd\xa6\x19\x5e\xc0\x40\x29\xb6\xe0\xa5\xb8\xa3\x89\x91\xc4\x7c\x39\x9a\xf3\x31\x62\xe6\x41\x92\x93\x37\x9b\xb2\xe0\x4e\xdf\x1c\x4a\xf5\x2a\x9a\xce\xfd\xab\x3d\x9a\xa5\xab\xd7\x40\xa3\xc8\xfc\x6f\x76\x0e\x08\x56\x17\x7a\x69\xbb\x02\xe8\x94\x12\x1f\x07\x85\x97\xc1\xab\x1f\x7e\xe8\x16\xff\x9f\x3e\x41\xd7\x44\x56\xf5\x6a\x17\xe5\xb9\x90\x7d\xe4\x5f\x4d\xea\xd7\xf1\xd4\xf3\xa8\x06\xbd\xf6\x41\xee\xc4\x39\xa9\xd7\x5a\x95\x8e\xdb\x2f\xeb\xfd\x50\xc7\x79\xbd\xbf\xa3\x16\x5e\xa0\x06\xb3\x71\xaa\x93\xfb\xa3\x4e\xfb\x46\xd2\x9d\x89\x77\x62\x6d\x9a\xb8\x63\x20\x02\xc2\x4c\x69\x91\x90\x50\xc4\x59\xc2\x55\xdf\xb0\x64\x91\xec\x75\x55\x8a\x61\xb7\x8a\x1c\x2e\xc8\x12\x69\x84\x52\xb5\x67\xe4\x2d\x9d\xdc\x28\x36\x57\xea\x39\x29\xbd\x48\x28\x0b\x0d\xa7\x39\x35\x2d\x37\x2d\xd6\xf0\xac\xe5\x2f\xb1\x48\x9e\x33\x15\x9a\x60\xc0\xa8\xbf\x93\x7d\x91\xdb\xd9\xdc\xc6\x56\xa1\xe1\x92\x2a\xe0\x42\xc3\x06\x35\x3c\x20\x72\xa0\xd6\xcd\x5d\xa1\x64\x52\xb5\x35\xeb\x4b\xb0\xa7\x9e\x16\x33\xef\xbf\x20\x95\x22\x34\x4e\xaa\x85\xb5\xbe\x47\xf4\xa5\x0d\x05\xbd\x44\x6e\x80\x92\x54\xc7\x1b\x78\x64\x71\x0c\x4c\x77\xab\xfc\x4a\x0c\xdb\x16\x15\xda\x92\x2a\x2d\xbb\xdd\xed\xba\xc4\x8f\xd2\xfb\xa3\x42\xaa\x3e\xd8\xfa\x84\x4c\xf2\x67\x3b\x12\xb0\x13\xf2\xed\x26\x72\x42\x95\x9a\x2d\x65\x06\x64\x20\x17\x99\xc9\x13\x96\x59\xc9\xb6\x9e\x07\x64\xc6\x4b\x1b\x98\x32\x3b\x86\x5c\x05\x53\x64\x46\xa5\x1a\x15\x8a\xeb\xef\x8d\x1b\x42\xb0\xa6\x4c\x9b\xac\xa0\x45\x09\x6a\xd0\xc1\x98\xcd\xab\x62\xd7\x4b\x16\xe3\x93\x26\x82\xed\x4a\xdb\xe7\x35\x8d\x11\x53\xc3\xcc\x64\xd9\xb3\x53\xe5\x7d\x96\x67\x8a\x01\x76\x8c\x6f\xcc\x68\x69\x01\x51\x18\xc2\xd9\x69\x1d\xa0\xfe\xb4\xc7\x5d\xeb\x20\xcf\x74\xdb\x02\x29\xaf\x7c\xb7\x3a\x9f\x36\x8f\xe4\x62\x0d\x7a\x49\x35\xac\x11\x96\x74\x85\x20\x32\x09\xce\x31\x8d\xb6\xc5\xf6\x52\x80\x0b\x7b\x5c\xd8\xe6\x44\x7f\xbb\x43\x8c\x49\xf9\x78\x95\x69\x32\xce\xe2\xb8\x56\x8d\x57\x47\x87\xbb\x4b\xab\x22\xfa\x6c\x18\x65\x4e\xec\xbc\x72\xf2\x62\x79\x7f\xe1\xe0\xc0\x90\xaf\x7a\xc3\xab\xf1\x6c\x30\x1a\x5f\x5c\xdf\x8d\x2f\x66\x37\x57\xd7\xbf\xf5\x83\xe7\xd5\x35\x0e\x7d\x3c\x98\xb5\x20\x6e\x55\xc5\x15\xc2\xe4\xea\xfc\xee\xed\x8d\x81\xb5\x42\xd6\xd6\x3e\x8c\x26\x77\xf6\x7b\x07\x67\xa7\x5d\xfb\xdf\xc9\xcf\x5b\xed\x85\xd7\x29\xd9\x14\x17\x52\x1d\x2e\xcb\x14\xe7\x9c\xf6\x42\x4a\x21\xe1\xfe\xe8\xae\x9c\x43\xc3\x56\xab\xf1\xac\xe9\xde\xae\xcb\x21\xb5\xee\xa5\xba\xf3\xe1\xb7\x30\x46\xa1\xd1\x78\x76\x71\xfd\x66\x30\xbc\xb8\x9b\x5d\xdd\x0d\xce\xcf\xef\xa6\x17\xd7\x1f\x46\xc3\x8b\x3b\xd3\x67\xb4\x6f\x9b\xde\x64\x8a\xd8\x22\x25\xdf\xac\x56\xfd\xef\x81\xb8\x37\x6e\x46\x63\x47\xa8\xa9\xd9\x35\xdb\x26\x57\x47\xcd\x89\x55\xfb\x66\xf7\x45\x93\x14\x37\x3c\xf8\x69\xc7\xf0\xc0\xae\x40\xa6\x50\xb9\x22\x9d\x3c\x50\x85\x51\x59\xd3\x3b\x7e\x99\x74\x87\x99\x4a\x98\x82\x5e\x9a\x52\x27\x2a\xde\x75\x22\x77\x41\x10\xce\x7e\x48\x8e\xeb\x5d\x76\x96\x46\xa6\xd8\xab\x2a\xad\xf1\x68\xe8\xf5\xd0\xdb\x1f\x21\x6f\xa1\x1d\x53\xa2\x36\x3c\x34\x3d\x32\x13\x51\xff\x55\xd2\x1c\x9a\xb4\xa0\x7f\xb1\x6f\xd4\xaf\xfd\xb4\x8e\x7f\xc7\xd3\xe9\xe5\xb4\x08\xd3\xb2\x3d\x52\x68\x42\x38\xcf\x3a\xf9\x38\xd8\x7c\x1e\xae\x54\x02\x8c\x2b\x6d\x3a\x99\xa2\x07\x1a\xf6\xca\x4b\x02\xe5\xb9\x69\xfe\x62\x62\xca\x91\xe9\x12\xe3\xf8\x76\x75\xd6\x3d\xbd\x4d\xcd\xb3\x3b\x21\xc7\x8f\xd8\x24\x6c\xaa\xae\x82\xe8\x20\x4d\xcf\x99\xc4\x50\x0b\xb9\x69\x9f\x43\xef\xc4\x2b\xaf\x72\xaa\x3d\x81\xb2\x9b\xc2\x39\x53\x69\x4c\x37\xb6\x5c\xfe\xad\xdd\x02\x35\x70\x2c\xcf\x1f\x9f\x03\x6e\x45\x80\x22\xe0\x06\xef\x67\x57\x77\xd3\xd9\xe0\x7a\xb6\x0f\xe7\xca\xde\x64\xb0\x02\xbd\x13\x21\x8d\x9d\x95\xf7\x61\xd8\x1e\xa5\x60\x72\x33\x1a\x7f\xff\xea\xee\xea\x66\x7c\x37\xb9\xbe\x1a\x5e\x4c\xa7\x4f\xd8\x6f\xb6\x94\x42\xeb\x18\xe1\xec\x87\xd3\xd3\x27\x60\xa7\x3a\x12\x99\x75\x81\xaa\xe2\x88\xc5\xe2\x69\x2c\x94\xb2\x8e\x85\x52\x3e\x0f\x53\x64\x7a\x68\xba\x2c\x26\xb8\xf9\x54\x42\x31\x6b\xfb\xd7\xcf\xe2\xf9\x35\x98\xd7\xc2\xb4\xe8\xf6\xb8\x02\xce\x9e\x05\x7b\xc5\x63\xc6\xf1\x99\xc0\x53\x93\x00\x23\x05\x3f\xff\xf8\xfa\x49\x73\x3b\x8c\x5f\x36\xda\x88\x72\xfa\xfa\xe7\x1f\x7e\xfa\x71\x3b\x4b\x36\xef\x3e\x98\x46\xde\xdd\xaf\xda\x4e\x90\x26\xcf\xbb\xc2\xb7\xe6\xbb\x9f\xbd\xfd\xbc\x4c\x05\x36\xcb\xef\x4b\x06\xae\x7b\xfd\xe6\xe9\xc0\x91\xfd\x9a\x84\x50\x62\xee\x48\x09\xcd\xfc\xb8\x8f\x4a\x33\x2d\xb4\x5a\xa3\x81\x82\x29\xf2\xe8\x8a\x17\x57\x44\x9e\x48\x0f\x05\x52\x3d\x9f\x3c\x83\xcf\x97\xe6\x14\x87\xf5\x65\x59\xc5\xe1\x7c\x5d\x5e\x29\x3f\xc3\xb3\x32\x4b\x09\xdd\xcc\x2d\xae\xee\xd8\x93\x23\x7c\xcc\x5a\x7e\xc9\x2b\x96\xfd\x19\xa6\xc4\x7e\x56\xbc\x37\xa0\x9f\x8a\xf8\x06\xf8\xb3\x62\xbe\x81\xf3\x8f\x45\xbd\xe7\x61\xdb\x03\x0c\x53\xf4\x5f\x7c\x4c\x63\x21\x51\x6e\x15\x08\x98\x2f\x98\xc2\xc9\xb6\x23\x4c\x9b\x0a\x29\x53\xf4\xa1\x08\x27\x7b\xc4\xac\x31\xc9\xef\x19\xda\x6b\x86\xc1\xed\xed\xf4\xea\xcd\xec\x66\x70\x7d\x71\x5b\x5d\x24\xf4\xee\x1c\xde\x8e\xb8\x76\x35\x68\xc1\x3a\xf8\xa6\xd4\x6e\x6f\x7f\x91\x62\xad\x50\x5e\x24\x59\xec\xee\xe6\xd4\xe9\x37\x6e\x47\x7e\x43\x3e\xf9\x74\x6e\xc4\xb5\xa4\xdc\xb6\x0d\x29\xd5\xcc\x5d\x94\xb9\x14\x11\x16\x57\x25\x4f\x81\xd8\x60\x3b\xbf\x11\x32\xfa\xc6\xca\x5f\x52\xf6\x8f\x29\x6c\x69\x17\x97\x40\x5d\x62\x9a\xd0\x05\x06\xb9\x3a\x53\x3b\xda\x2f\x94\x5c\x6a\x9d\xf6\x4e\x4e\x1e\x18\x5f\x74\x43\x91\xb4\xcc\x86\x5e\x98\xae\xd3\x4e\x4d\xed\x19\x92\xe9\xf3\xcb\x83\xe8\x2e\xc0\x6c\xc9\x14\xac\x59\x1c\xe7\x2d\xae\xeb\x3a\x2d\x57\x97\x45\x41\x8b\x82\x50\xd8\xbb\xb5\xdd\xc7\x39\xd5\xf4\x76\x68\x67\x63\xe6\x9f\x53\xe3\xc9\x53\x0b\x6c\xb2\x83\x37\x42\xd8\x88\x0c\x42\xca\xe1\xfa\x7c\x02\x05\x11\x3b\x32\xc2\xf2\x68\x2d\xa1\xe1\x92\x71\x74\x48\x32\xe3\x8e\xbf\xe3\x9c\x50\xee\x4e\x04\xb4\x80\xb5\xeb\x06\x73\x1a\x4b\xcc\xc5\xf5\x66\x45\xee\xe7\x1f\x7e\x90\x96\xd7\x84\x21\x28\x7f\xdc\x64\x6c\xb7\xf3\x17\x16\xdd\x6e\x17\xd6\x4c\x2f\x61\x34\x01\xff\xb4\xa8\x95\x24\x4d\x53\x23\x5a\x71\x03\x17\xec\x2d\x68\xe5\xae\x0b\xfb\x57\x1c\x5b\x6e\x13\xef\xa0\x18\x89\x35\x8f\x05\x8d\xca\x61\xc5\x43\xde\xa9\x59\xe3\x64\xbc\xba\xa7\x6a\xfe\x34\x6f\xb8\xee\x20\x6a\xff\x09\xee\xf4\xbd\xbc\x14\x55\x87\xf3\xee\x2a\xee\x25\x62\xc4\xda\x45\xa3\xba\xaa\xb5\x83\xc4\xd0\xcd\xf5\xcd\xa7\x9b\xd8\x0b\x14\xe5\x8d\x99\xe6\xfd\x17\x8f\xf6\xf6\xf5\x9a\x1d\xd4\xd7\xa5\x80\x71\x91\x99\xed\x4d\x5b\xfb\x35\x8b\xa9\x15\x88\x39\x14\xb3\x92\x56\x05\x9a\x77\x6b\xa0\x9a\xe0\xb6\x72\x2d\x4a\x37\xa3\x94\x69\x03\x8b\xa6\xb8\xa1\x41\xad\x43\x6c\x25\x64\x42\x74\x57\xc6\x2e\x9c\xa8\xda\x43\xda\x3d\xdc\x04\x48\x7e\x87\xd6\x39\xce\xc6\x23\xb0\x7d\xa1\x78\xb7\x24\x59\x0a\x26\xa9\xc6\xa8\x7d\x45\x9e\xdc\x29\x6d\xf8\x3d\x31\x74\xb4\x2c\xae\xf1\x41\x08\x6d\x33\x51\x6a\x88\x98\x90\xcc\xe5\xd6\x02\x1e\x10\x70\x3e\xc7\x50\xb3\x15\x5a\xbf\x77\xc9\x28\xff\xb4\x27\xd5\x19\xcf\xb6\xad\xcd\x9f\x6b\xb4\xf0\xc4\x68\x90\x69\x94\x5b\xd3\xc1\xfc\x2f\x34\xc9\xb0\x65\xda\xf1\x88\x98\xd6\x93\x24\xa4\x99\x4c\x85\x42\xd5\x6a\xaf\xee\x8e\x44\x98\xaa\x33\x20\x45\x0a\xa9\x92\x09\x90\xad\xbb\x3d\x5b\xa7\xc7\x05\x5e\x75\x67\x6e\xfb\x17\x86\x40\x8a\x3b\xb1\xd5\x2f\x04\x81\x14\x37\xdf\xaa\x5f\xf8\x01\xa9\xcf\x8b\x9a\xe3\x23\xef\x56\x6f\xed\x97\x76\xde\x8a\xbb\xc7\xbb\xf5\x83\xb9\xc0\xaf\x77\x76\xcf\xea\xf2\x51\xdd\xff\x07\x00\x00\xff\xff\x68\x06\x89\xcf\xba\x3a\x00\x00")
+var _kuberneteswindowssetupPs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x1b\xef\x73\xdb\xb6\xee\x7b\xee\xf2\x3f\xf0\xd4\x7c\x70\x6e\x95\x92\x74\xed\xb6\xf3\x3d\xbf\x37\xcf\x76\x3b\x5f\x1b\xc7\xcf\x72\x9b\x7b\x6f\x7e\x97\x28\x12\x6d\x6b\x91\x45\x4d\x94\xec\x7a\x5d\xff\xf7\x07\x90\x94\x44\xfd\x72\xdc\x5e\xe7\x5e\x2f\x96\x08\x80\x00\x08\x80\x00\x48\xff\xe3\xd9\xe9\x09\x81\x8f\x65\xff\x67\x72\x33\xb5\xc7\xb6\x7c\xc4\xcf\x34\x66\x5b\x9f\xfb\x2c\xe4\xe4\xc3\x35\x71\x38\x71\xc8\xdb\xf4\x81\xc6\x21\x4d\x28\x3c\xac\x68\x98\x58\xa7\x27\x0a\x7d\x38\xb2\x07\xb3\xf1\x74\x3e\xbe\x99\x7c\x29\x85\x67\xff\x3c\x3d\xf9\x6d\xb0\xf1\x02\x9a\xfc\xe2\x87\x9e\x1f\xae\x3a\x43\xba\x74\xd2\x20\x99\x3a\xb1\xb3\x01\xd8\xd8\xa6\xc9\x04\xbe\xf5\x0c\x3b\x71\x42\xcf\x89\x3d\xe3\xfc\x7f\xa7\x27\x11\x0e\x77\xe4\x74\xbf\xf1\x24\x06\xcc\xff\xa9\xa7\x0f\x4e\xe0\x7b\x4e\x42\x27\x2c\x99\xa4\x41\x70\x13\x8f\x36\x51\xb2\xef\x9c\xab\xf1\xb3\x6b\x87\x03\xd9\xf1\xf4\x79\x26\xc0\x6f\x51\x36\x57\x0e\xf4\x24\x11\x94\x65\x18\x72\x9b\xc6\x5b\xdf\xa5\xe3\xa8\x89\xd8\x35\xf2\x9b\xb0\x78\xdf\x3b\x4b\xe2\x94\x1e\x4d\x5b\x32\xf8\xfa\xdf\xc3\xc9\x34\xa6\x4b\xff\xe3\xb7\xa4\xfd\x8e\xb9\x4e\x02\xab\xf2\x2d\x69\xf6\x71\x31\xdf\xd2\xfd\x37\xa5\xf9\x67\x1a\xd3\x5f\x19\x4f\x42\x20\xf4\x4d\x09\xf7\x87\x83\xc0\x07\x86\xc7\xde\xdf\x42\xd6\xa6\x6e\x4c\x93\xd3\x93\x73\x24\x7e\xb6\x0a\xd8\x83\x13\x74\x07\xfd\x01\x8d\x13\x7f\xe9\x83\xf2\x29\xe9\x11\xe3\xd3\xa7\xdb\xd8\x89\xfa\xfc\x83\x13\xfb\xce\x43\x40\x89\xe1\x3a\x1a\x88\xf1\xf9\xb3\x51\x60\x0b\xfd\x3e\x4d\x40\x4c\xdf\x4a\x64\xc8\xdc\x47\x74\x26\x61\xaf\xe8\x50\x48\x45\xbe\xd4\xa0\x66\xb3\xbe\x5d\x81\x99\xd1\x0d\x4b\x68\xdf\x75\x29\xe7\x1a\xa4\x70\x00\x3f\x46\x08\xb7\xbb\x78\xac\x8c\x80\x33\x03\x63\x94\xdb\x7d\xfb\xfd\xec\x9d\x64\xf8\x0d\x4d\xa4\xa5\xd4\xc7\xad\x12\xab\x3a\xc0\x07\x1a\x63\x10\x69\xa7\x90\x01\x94\x49\xdc\x42\x30\x61\x3b\x3e\xa7\x01\x85\x75\x8d\xf7\x6f\xde\x8f\x87\xcd\x6a\xdb\x35\x40\x1a\x35\x76\x20\x3e\x41\xf8\x89\x93\xd7\x7e\x80\x4a\xa9\x2a\xe1\x3b\x62\x2c\x1e\x25\x18\x47\x30\x2b\xe2\x57\x15\x0a\x10\x0f\x3f\xee\x8f\xa1\x11\x21\x60\x13\x95\x89\x93\x4c\x68\xb2\x63\xf1\xa3\x8c\x87\xa1\x93\x68\xa3\xf3\xd8\x09\x39\xd8\x31\xa8\xa7\x04\x95\x94\xde\x1b\xba\x55\xce\x69\xe8\xa0\x1f\x34\x2b\x26\x91\xa3\x15\x65\xd8\xe9\x03\x77\x63\x3f\xc2\x18\xd2\x86\xc9\x4b\x30\x65\xfc\x19\xe5\x2c\x8d\x5d\xfa\x26\x66\x69\xd4\x8c\x1e\xeb\x20\xb5\xd9\x43\xb9\x1d\xb4\xce\xac\xc6\x2b\x78\xd4\x4d\x63\x3f\xd9\x0b\x92\xed\xe8\x21\x5f\xd5\x71\x3f\x4c\x0e\xcd\xb8\xf5\xe3\x24\x75\x02\x4d\xe5\x15\x79\x59\x9a\xd0\x39\xc2\xb6\xd3\x88\x4b\x30\x65\xfc\x69\xec\x6f\x9c\x78\xdf\xdf\x3a\x7e\xe0\x3c\xf8\x01\x08\x61\x1f\xe2\x27\x6a\x85\x2f\xd3\x9d\x50\xea\x4d\x9d\xc4\x5d\x83\xa7\x4c\xfa\x73\xb4\xc7\xa5\x13\x70\xaa\xdb\xc7\x7b\x4e\x21\x1c\xc2\x56\xed\x8d\x3d\x30\x1f\x20\x35\xfa\x08\x56\x51\xf8\x63\x75\xf2\xb4\x1d\xa1\x3c\x3b\x50\x1e\x87\x60\xe3\xa1\x4b\xaf\x69\xe2\x40\x60\x75\x5a\x29\x56\x01\x25\xa5\xd3\x93\xa5\x1f\x40\xc0\x26\x73\x7f\x43\x01\x60\x13\x91\x4f\xc6\x59\x07\x22\x84\x39\xc4\x10\x69\xbe\x66\xf1\xc6\x49\x08\x3b\xef\x92\xb3\x3b\xe3\xb3\xc0\x48\x43\x17\x6d\xf2\xf4\xe4\x16\x8c\x81\x9a\xef\xd8\xaa\x73\x06\xd8\x1c\x38\x86\x70\xfd\x49\x85\xf2\x0d\x5f\xa1\x3a\xd4\x00\xf9\xab\x98\x42\x02\x48\xe4\x9b\x34\x89\xd2\x44\x40\x9f\x9e\x94\xc8\x13\x50\xb8\x99\xc7\x13\x78\x48\x30\xad\x29\x26\xc0\xe1\x71\x42\x37\x10\x11\x22\x88\xd6\x7b\x62\xc2\x42\xac\x89\xf1\xeb\xdb\x77\xd7\xdd\x85\xcd\x96\xc9\x0e\xdc\x75\x71\xed\xbb\x31\xe3\xf0\xb4\x50\xc1\x6c\x31\x48\x63\x74\x63\x15\xf1\x16\x53\x16\xf8\x2e\x44\xc0\x05\x08\xec\x0c\x58\x10\x50\x31\xbd\x41\x4c\x61\x1e\xc6\x80\x6d\x36\x34\x76\x7d\x27\x00\x27\x24\x26\xec\x60\x29\x25\x07\x03\x24\x2a\xcd\xa5\x15\x71\x4e\x4f\x46\x1f\x23\xd8\x15\xcd\xff\x8e\xa7\x18\xba\x3a\x67\xa0\x79\xfa\x9c\x9c\x79\xa0\x14\x08\xc2\x08\xa3\x69\x8f\xaf\x69\x10\x80\xfe\x42\xba\x33\xd9\xc3\xef\xc0\x13\x31\x5d\xb6\x21\xe2\xbd\xe5\x44\x51\xe0\xcb\x0c\x44\xc1\xff\xe9\x63\x18\x90\x68\x16\x32\x6e\x47\x8e\xab\x26\x39\x97\x30\x4b\x16\x53\xc7\x5d\x77\xce\x40\xef\x1b\xe2\x87\x02\xc9\xc2\x07\xde\x39\x57\x30\x9f\x8a\xcc\x53\xa3\xc5\x25\x2d\x9d\x53\xcb\x65\xd1\x7e\x4d\x63\x2a\xc9\x29\xf4\xcf\x35\xa1\xd1\x92\xf4\x7d\x46\x5b\x40\x9c\x7e\x29\x83\xb8\xd8\xfb\x2c\x78\x36\xe4\xd0\x38\xdc\xb2\x47\x6a\xde\xd2\x87\x19\xfd\x23\x85\x69\x89\xf9\x3e\xf6\xc9\x81\xad\x11\x0d\x49\xec\x08\x19\x55\x49\xa8\xac\x73\x58\x19\x1d\x84\x98\xc3\x42\x22\x32\xe8\x2e\x6a\xdc\x0b\xd7\x36\xa5\x6f\x8b\x09\xf7\x3a\xff\xb0\xe7\x01\x2e\x84\xc4\xb8\x65\x1f\x92\x00\x16\xdf\x73\x25\x97\xbf\x24\x9d\x39\xcc\x29\x4d\x55\x23\xd0\xa0\xfe\xf6\x08\x83\x19\x95\x06\x28\xa9\xc0\x24\xa8\xc8\x33\x1a\x6e\xbb\x36\x6c\x7c\x74\x33\x63\x2c\x59\xc8\xaf\xdf\xbf\x58\x78\xb1\xbf\x05\x8b\xaf\xf3\x24\x7c\x29\x61\x91\xa9\x12\x16\x22\x21\x8a\xc1\xc4\x79\xa4\x6c\x17\x92\x8b\xa5\x36\x57\x31\x0c\x76\xe8\x06\x5c\x67\xe3\x62\x05\x9b\x65\x42\x8c\xbe\xb7\xf1\x43\x1f\xaa\x0a\xcc\x04\x79\xb7\xf3\xfa\xdc\x40\x84\x02\x75\x00\x36\x24\x7c\xb8\xa4\xcb\xa6\x49\x1e\x5c\x8f\x7a\x7e\x42\x2e\x38\x4d\xc8\x7c\x64\xcf\xed\xf1\x9b\xc9\x78\xf2\x86\x64\x0e\x50\xb7\x3c\x19\x5f\x44\x02\x3c\x60\xe1\xd2\xd7\x63\xc7\x99\x53\xbc\x3e\x90\x47\x08\x28\xeb\x77\x0e\xd1\x20\xcb\x71\x75\x4c\xc0\xfa\xd9\xc8\x69\x66\x3b\xbd\x67\x74\x61\x19\x2a\xb9\x81\xf1\x5c\x01\x55\x36\x75\x0d\xb4\x9c\x12\xe4\x08\x8e\xe3\x65\x89\xb6\x80\xd6\x12\xef\x3a\x8c\xcc\x9a\xcb\x70\xea\x5d\x06\x5b\x4e\x0b\xb4\xf9\x4b\x29\x45\x0e\x1e\xa8\x42\x47\x40\x66\x55\x8f\x2e\x4d\x96\x28\x94\x25\xc9\xde\xe6\x80\xd5\xcc\xa1\x04\x5f\x1b\xcc\xd0\xb6\x0d\xd4\xb3\x44\xa2\x90\xa8\xbc\xef\xeb\x22\x95\x47\x32\x84\x03\x9b\xbb\x86\xdc\x9e\x32\xe4\x84\x0e\x6d\xd4\x5d\x72\xc4\xfe\xaf\x11\xaa\xed\xcf\x25\x02\xd5\x51\x61\xed\xc6\xcf\x8d\x56\xf9\x17\x81\x58\x28\x23\x9d\x49\x43\x97\x61\x3b\x80\xf4\xed\xc1\x78\x4c\x4c\x0c\x7b\x91\xd8\x26\xab\x2e\xa0\x5c\xb3\xd1\x89\xd0\x27\xea\x3e\xf4\x98\xbf\x3d\xe0\x42\xae\x00\x28\xdc\xa7\x40\x52\xde\x63\x9a\xe6\xe9\x89\x13\xf9\x6a\x3b\xee\x92\xed\xd5\xe9\x89\x1b\xa4\x58\xbb\xf3\x2e\x8c\x13\xf5\xd0\x95\x14\xdc\xa2\x32\x33\x9d\x34\x59\x33\xb4\x1c\x13\x75\xa2\xad\x5d\xa9\x50\x54\x71\x8e\x43\x78\x03\x2a\x64\x9d\x24\x11\xef\x5e\x5c\x9c\x7d\xca\x3a\x18\x9f\xbb\x2f\x5f\x7e\x8f\x40\x58\x24\x23\x95\x6a\xe7\x00\x28\x80\x1c\x09\xfd\x98\x28\x8e\xe4\x43\xc6\x91\xe2\xaf\x19\x11\x21\x60\x79\x1b\x87\x4d\x07\x23\xa4\xf1\xd4\xd4\x32\x5f\x31\xb3\x59\x9b\xa1\x1e\x21\x13\xe9\x12\xa9\xda\xd3\x13\x9c\x51\xf2\xda\x46\x58\x9b\x5b\xb0\x97\xc9\xe2\x8b\xa9\x34\x25\x57\x54\x5b\xad\xa2\x8d\x12\xe2\x23\x2d\xd6\x22\xeb\x67\x18\x25\x4b\xd5\x0c\xe0\x48\x43\x2d\xdb\x59\xb3\x9d\x4e\x20\x4d\x1a\x87\xcb\x18\x12\xb8\x30\x71\xfc\x10\x7b\x50\xb9\xa5\xba\x5e\xd5\x34\xe5\x7b\x4f\x94\xed\xe4\x21\xf5\x03\x8f\x98\x09\x81\x89\xa0\xf2\x84\xdd\xe7\x22\x72\x40\x27\xc4\x3a\xe4\x0f\xb2\x03\x97\x57\xa2\xbc\x73\x16\x31\x6f\x30\x1e\xce\x34\x0f\x51\x25\x6f\x3f\x5e\xbd\x83\x6d\x10\x0d\xbe\x63\x98\xe6\x5a\xb5\x63\x4c\x06\xf6\x18\xfb\x1e\xed\xdd\xe7\xca\xd5\xdb\x35\xc6\x73\x00\x06\xa2\xa6\x8f\x82\x89\xe5\x17\x92\x99\x10\x97\x56\xb4\x57\xe1\x56\x40\x63\x70\x0f\xb6\x08\xba\xec\x19\xd9\x07\x07\x50\x87\xd2\x15\x7b\x98\x6a\x65\x6e\x89\x43\x6e\xc0\x52\xcf\x8c\xb0\xcf\xe8\xd1\xb8\x27\xc2\x82\x36\xa0\x63\x69\xfb\xe1\x79\x59\x44\x4c\x92\x21\xd1\x7a\x07\xfc\x29\xbf\x16\x08\xaa\x98\xb7\xe8\x47\x58\xe2\xa3\x05\x27\x5f\x20\x36\xa9\x0a\x0d\x2f\x9c\x20\x60\x3b\x90\xc8\xdf\xc2\xc2\x40\xd8\xed\x61\xc2\x04\xef\x61\x2f\x06\x5c\xd3\xa3\x0f\xe9\x6a\x05\xb6\x66\xae\x81\xe5\x00\x1c\x85\xa0\xb0\xc2\x89\x4d\x2f\xe4\x05\x57\xd5\xee\xa4\x0e\xc7\x36\xc0\x55\x4f\x3d\x5a\xb8\x4d\x06\x84\xb4\x29\x1a\x85\x77\xfc\x38\xf2\x43\x73\xc3\x40\x6e\xd0\xf6\xc6\xe7\x6e\xca\x52\x6e\x3e\x80\x26\x56\xc8\xde\xb6\xf7\x02\x99\x47\x4d\x68\x52\xc7\x74\x85\x29\xd4\xbe\x65\x21\x50\xfe\x14\x36\x15\xd0\x6a\x2c\x53\x65\x13\x1f\x60\xe3\xeb\x5d\x5d\x6e\x08\x69\x5e\x5f\x72\x78\x75\x75\x87\xc5\x94\xf5\x50\x47\xc9\x0c\x20\xd3\xbb\xb2\x7e\xb2\x2e\x8d\x7a\x12\xfb\x0c\x05\x8a\x7c\x53\xc6\x5e\xe2\xd1\x28\xa6\x18\x34\x38\x59\x82\x06\x88\x40\xd3\x32\xd9\x8a\xbf\x7c\x07\xf9\xac\x8e\xcf\x7b\x59\xec\xbe\x3f\xfb\xa4\x58\x2a\xc5\x70\xa3\x4e\x4b\x37\x4c\xa4\x47\xbe\x86\xe0\xe7\xa3\x95\x01\x0b\x09\xca\xf8\xa1\x45\x19\x1c\xd2\x6c\x88\xb6\x18\xe6\xf2\x05\x26\x6a\xf9\xa0\x20\x83\x79\x97\x50\x68\x65\xba\x01\x2a\xdf\x91\x0e\x5b\x42\x9c\x85\xe2\x33\xd8\x17\xea\xf3\x32\x90\x1f\xad\xcb\x73\x2d\x13\x3f\x6e\xa9\x7e\x2c\xb8\xab\x70\xd8\xbe\x08\xca\x75\x20\x3f\xed\x89\xfe\x86\xd1\x8c\xd4\xa0\xed\x03\x98\x9f\x75\xdd\x6c\xa0\x3a\x25\x52\x11\x1c\xaa\x5e\x0a\xf9\x3e\x49\x18\x89\x52\x28\x83\x55\x73\x51\x6d\xe1\x44\x04\x02\x4e\x3a\xcb\xc0\x59\x11\x9e\x46\x11\x8b\x35\x9d\xfc\x50\xd2\xc9\x01\xce\x04\x19\x13\x27\x40\xef\x58\x41\x18\xe1\x10\x1b\x1c\x2f\x00\x98\xde\x0b\x70\x1e\x70\x92\x15\xa6\xa4\xdc\x8c\xc0\x11\xff\x60\x5c\x4a\x20\x84\x5a\x62\x89\x6f\x86\xe0\xcc\x22\xdc\xc0\xaa\xa0\x98\xbd\x7b\xe3\xde\x28\x8c\xa6\x41\x9f\x76\x22\x7a\xbd\x00\x05\xe9\x51\xa7\xaa\x6c\xf3\x77\x06\x75\x39\x8c\x3e\x07\x80\x73\x4c\xa0\x04\xb9\x43\x94\x7e\xbe\xaf\x52\x81\x81\xfb\xf3\x72\xc6\x25\xb6\x29\x89\x80\xb1\x39\x0f\x70\x6d\xdd\xce\xc3\xe3\x3a\x85\x52\xe0\x06\xc4\xf2\x0e\xa6\x01\x66\x7e\xd5\x33\xf2\x93\x23\x7d\xb8\xda\x95\x6d\x7e\xaf\x63\x54\x83\x33\xe0\x54\x5f\x55\xc1\x2b\x0e\x51\xcc\xd2\x30\x68\xd4\xfb\x18\x53\xe6\xbd\x01\xef\xdb\x39\xfb\xce\x7d\x7d\xb7\x87\x22\x2b\x8d\x43\x92\x8f\x58\x58\xea\x89\x13\xb5\xce\xe5\xf3\xe2\x6d\x00\xc2\x8f\x43\x8f\x7e\xbc\x59\x76\x0c\xcb\x38\x17\xab\x6c\x61\x83\xba\x92\x6a\x60\x7f\x4b\x1e\x2c\x28\x05\x34\x4d\xfa\x8c\xcc\x71\x52\x08\x12\xe4\xb5\x1f\x03\x6b\xe0\x2d\xe0\x35\xd2\xe9\x08\x80\x73\x7c\x4c\x9c\xe0\x11\xff\x72\x55\xe1\xd3\xd0\x8b\xc0\xd0\x12\x6e\x91\x8e\xb2\x1d\xc2\xd7\x2c\x85\x34\x88\x6e\x61\xad\x53\x19\x6e\x00\x71\xed\x73\xe5\x4c\x90\xee\xf0\x35\x71\xbc\xed\x32\x9b\x07\x4b\x71\xf8\x0b\xbe\x83\xc9\x1a\x78\x67\x82\x1d\x43\x60\x25\x33\xbd\xfb\x33\x99\x61\x95\xad\xa8\xa7\xd2\xae\x50\x4a\x45\x02\xdc\x7e\xff\x48\x7d\xa0\x66\x9a\xaa\x1b\x69\x4c\xfa\xd7\xa3\xde\x13\x66\xaa\xf5\x54\x9a\x67\xb2\x02\x1a\xae\x20\x85\x34\xe9\x1f\xe4\xb2\x1e\x8f\x85\x3a\xdf\xdc\xf6\x0e\x2c\xad\x1e\xa0\xa0\x8a\x46\xf9\x42\xba\x23\xda\x61\x40\x26\x46\x01\x59\x11\x4f\xa1\x99\xa6\x6c\xc0\xf4\x74\x5c\xd3\x94\x05\x74\x2f\x9f\x13\x5e\xad\x24\x1f\x3d\xc5\x1e\x79\x42\x0b\x3a\x93\xba\x6c\xdb\x0d\xdf\xf9\x89\xbb\x06\x97\x5f\x81\x80\xdb\x8d\x2d\x1f\x21\xeb\xfe\x17\x91\xdf\xe7\xfb\x08\x18\x1b\xfd\x9b\x60\x3d\x1a\x87\x4e\xd0\x20\x2e\xe6\x6c\x64\x1b\xfa\x2e\xb6\x0f\x89\xe2\x8d\xf8\x11\x9a\x13\xbc\xd9\x39\x31\x84\xe9\x35\x45\x95\xe0\x46\x45\x20\xc6\x92\xfc\xf8\x05\x81\x02\x88\x48\x14\x2c\x14\x03\xf7\x87\xf1\xb4\x98\xa2\xef\x79\xe6\x87\x6b\x25\x49\xdf\x73\x22\x5c\x78\x53\x56\xca\x1b\x90\xf1\xc6\x56\x1d\x58\x35\x0d\x8e\x4a\xbe\xc5\xdb\x42\x42\xab\xaa\x86\x67\xa4\xcf\xb9\xbf\x0a\x73\x76\xc7\x53\xe4\x04\x57\xce\x51\xf3\x20\x9b\xca\x49\x14\x79\xdc\x94\x61\x8f\x14\xf2\x2a\x28\xde\x2d\x68\x4a\xf3\x2f\xf6\x68\x3f\xda\xbe\x04\x38\x0f\xff\xe3\xce\x41\x8c\xed\x08\xf4\x80\x55\x01\x6c\x4c\x19\xc7\xe7\x46\x66\x65\xe4\xc5\xab\x57\x56\xf6\xff\xf2\x09\xba\xe8\x59\xc5\xab\x36\xca\xf0\xbd\x47\xc3\xaf\x26\xf5\xeb\xc4\xd6\x2c\xaa\x42\xaf\xb9\x91\x3b\x95\x46\xaa\x95\x56\xb9\xe1\xf6\xf2\x7c\xdf\x4d\x02\x95\xef\xb7\xe4\xc2\x60\x8e\x04\x37\x4e\x0e\x59\x57\xa7\x79\x23\xb1\xe6\xec\x1d\xdb\x61\x11\x77\x4e\x4c\x46\x5c\x48\xb2\xd9\x06\x92\xd5\x20\xdd\x40\x7e\x8e\x53\xfa\x5e\xdc\xb5\x78\x44\x5d\xab\xf0\x9c\x90\x99\x6b\xd8\xbf\x61\xe1\x9a\x23\x72\x4d\x26\xd9\x8a\x55\x42\x1d\x13\xd2\xb3\x80\xb2\x4a\xc8\xa5\xa2\x06\xa9\x79\x83\x36\x34\x6d\xe9\x43\xc0\xf5\x10\xf2\x7e\x74\x06\x28\x4b\x5a\xa7\xcf\x62\x3b\x84\x36\xf4\xad\x4c\xc2\xb5\x03\x99\x11\x4b\xc8\x1e\x14\xf8\x40\xc1\xa9\x1c\x61\xe6\x32\x51\xc2\x50\x2d\xd4\xfa\x9c\x88\x53\x4f\x81\xa9\xea\x2f\x02\xde\x88\xe7\xce\x08\x87\xda\xd7\x88\x3e\x17\xae\x00\x2f\x42\x04\xda\x44\x09\x04\xfe\x47\x1f\xa2\xbb\x8f\xf7\x4a\xb2\xf8\x6a\xe2\xb4\x0d\x22\x34\x05\x55\x27\xaf\x76\xeb\x79\x89\xee\xa5\x40\x4e\x71\xd5\x23\x22\x3f\x31\xa7\xea\x59\xb4\x04\x44\x87\xbc\x5e\x44\x4e\x41\xe6\xf9\x3a\x4e\x89\x09\x54\x53\x8c\x13\x62\xb2\x7c\xda\x72\x1c\x80\xdc\x3a\xd7\x01\xa6\xd9\x01\x51\x22\x60\x92\xe9\xe5\x62\x14\x28\xb2\xbe\x47\x33\x24\xc6\xce\xf1\xf1\xc8\x09\x95\x96\x81\x22\x3a\x41\xb5\x69\x59\xec\x6e\x8d\x0d\x8c\xa7\x54\x54\x51\x53\x75\x2e\x3b\xa0\x34\xc2\xc9\x30\xca\x5e\x5d\x72\x6d\x59\x8e\x64\x03\x3f\x52\x8d\x82\x16\x81\x12\xc7\x05\x4a\x65\x80\xf2\xd3\x01\x73\x2d\x83\x1c\x69\xb6\x19\x92\xca\x7c\x6b\x95\x4f\x93\x45\x86\x0c\xb6\xd3\xb5\x93\x90\x1d\xec\x35\xce\x16\xf2\x87\x34\x26\xd2\x30\x51\xda\x6c\x7b\xc9\xc0\x99\x38\x2e\x6c\x32\xa2\xbf\xe4\x21\xc6\x34\x7f\xc4\xde\x12\xde\x31\x29\x65\xe3\xc5\xd1\x61\x7b\x6a\x95\x79\x9f\x70\xa3\x54\xb2\xad\x32\x27\xcd\x97\x0f\x27\x0e\x12\x0c\xcf\x62\x06\x37\x93\x79\x7f\x3c\x19\xcd\xee\x26\xa3\xf9\xed\xcd\xec\x6d\xcf\x38\x2e\xaf\x91\xe8\x93\xfe\xbc\x01\xb1\x96\x15\x17\x08\xd3\x9b\xe1\x1d\xf0\x66\x28\x26\x4b\x63\xb0\xff\xde\x89\xf5\x36\xae\x2e\x2d\xf1\xef\xe2\xa7\x5a\x79\xa1\x55\x4a\x22\xc4\xb9\x78\xf8\x94\x87\x38\x69\xb4\xa3\x38\x06\x3b\xbd\x3f\xbb\xcb\xfb\xd0\x82\x42\xa9\xd4\x38\xaa\xbb\xd7\x76\x39\xa4\x54\xbd\x14\x77\x3e\xf4\x12\x06\x05\x1a\x4f\xe6\xa3\xd9\xeb\xfe\x60\x74\x37\xbf\xb9\xeb\x0f\x87\x77\xf6\x68\xf6\x61\x0c\x8f\x58\x67\x34\x6f\x9b\x5a\x67\xca\x14\x49\x8a\xda\xac\xb6\xbd\xef\xb1\xef\x84\x6f\x64\x8f\x46\xb4\x50\xf1\xe0\xb3\xb1\x73\x75\x56\xed\x58\x35\x6f\x76\x5f\xd4\x49\x91\xcd\x83\x1f\x5b\x9a\x07\x62\x04\x7b\xb5\x5c\x26\xe9\xe6\x83\xc3\x21\xf4\x67\x39\xbd\x9c\x2f\x8d\xe5\x61\x26\x67\x98\xd0\xc7\x98\xea\x78\xd9\xbb\x8e\x27\xaf\x04\x92\xab\x57\x9b\xf3\x72\x95\x9d\x46\x78\x29\x4b\xcb\xb4\x26\xe3\x81\x56\x43\xd7\x17\x41\x95\xd0\x72\x52\x93\xef\x43\x17\x6b\x64\x9f\x79\xbd\x17\x9b\x6a\xd3\xa4\x01\xfd\x8b\x6d\xa3\x7c\xed\xa7\xb1\xfd\x3b\xb1\xed\x6b\x3b\x73\xd3\xbc\x3c\x82\xfc\x07\x5c\x58\x45\x1d\xd5\x0e\xc6\xe5\x09\x39\xc7\x33\x70\xf0\x71\xd8\xeb\xb2\x1a\x68\xd0\xcd\x2f\x09\xe4\xe7\xa6\xea\xc5\x14\xd3\x11\x1b\x4f\xc4\x17\xdb\x2b\xeb\x72\x11\xe1\xb3\x3c\x21\x07\xfb\xa9\x12\xc6\xac\x2b\x23\xda\x8f\xa2\x21\x94\x4c\x2e\xde\x86\x6b\xee\x43\xb7\xe2\xe5\x97\x37\xf9\x01\x47\x69\xa7\x00\x31\x3b\x0a\x9c\xbd\x48\x97\xdf\x36\x6b\xa0\x04\x4e\xf3\xf3\xc7\x63\xc0\x05\x0b\x24\x73\xb8\xfe\x7b\xf0\x40\x7b\xde\x9f\xcd\x0f\xe1\xdc\x88\x9b\x0c\x82\x21\x3c\x4b\x0c\xa4\x96\x0f\x61\x88\x1a\x25\x9b\xe4\x76\x3c\xf9\xfe\xc5\xdd\xcd\xed\xe4\x6e\x3a\xbb\x19\x8c\x6c\xfb\x09\xfd\x41\xa2\xc0\x92\x04\x8c\xec\xea\xd5\xe5\xe5\x13\xb0\x76\xe2\xc1\xe6\x82\x26\x50\x64\x1c\x01\x5b\x3d\x8d\x05\x01\xa1\x8c\x05\x2f\x8e\xc3\x84\xf9\x06\x58\x65\x81\xc2\x71\xa9\x18\xf7\x85\xee\x5f\x1e\x35\xe7\xd7\x60\xce\x18\x96\xe8\xe2\xb8\x82\x5c\x1d\x05\x7b\x13\x62\x37\xec\x48\x60\x1b\x03\xa0\xc7\xc9\x4f\x3f\xbc\x7c\x52\xdd\x12\xe3\x97\x3d\xf6\x82\xaf\x2e\x5f\xfe\xf4\xea\xc7\x1f\xea\x51\xb2\x7a\xf7\x01\x0b\x79\x79\xbf\xaa\x1e\x20\x31\xce\xcb\xc4\xb7\x64\xbb\x9f\xb5\xfd\x3c\x0f\x05\x22\xca\x1f\x0a\x06\xb2\x7a\xfd\xe6\xe1\x40\x92\xfd\x9a\x80\x90\x63\xb6\x84\x84\x6a\x7c\x3c\x44\xa5\x1a\x16\x1a\xb5\x51\x41\xa1\x11\x0d\xbd\x9b\x30\xbb\x22\xf2\x44\x78\xc8\x90\xca\xf1\xe4\x88\x79\xbe\x34\xa6\x48\xac\x2f\x8b\x2a\x12\xe7\xeb\xe2\x4a\xbe\x0c\x47\x45\x96\x1c\xba\x1a\x5b\x64\xde\x71\x20\x46\xe8\x98\xa5\xf8\xa2\x32\x96\xc3\x11\x26\xc7\x3e\xca\xdf\x2b\xd0\x4f\x79\x7c\x05\xfc\x28\x9f\xaf\xe0\xfc\x6d\x5e\xaf\x59\x58\xbd\x81\x81\x49\xff\xe8\x63\x14\xb0\x98\xc6\xb5\x04\x81\xaa\x01\x4c\x9c\x44\x39\xe2\x27\x98\x21\xa5\x1c\x5b\x44\x12\x54\x1c\x31\xe3\x5d\x25\x79\x79\x4b\x5c\x33\x34\x16\x0b\xfb\xe6\xf5\xfc\xb6\x3f\x1b\x2d\x8a\x8b\x84\xda\x9d\xc3\xc5\x18\x7b\x2f\xc8\x65\x36\xb5\xf1\x4d\xa9\x2d\x16\xbf\xc4\x10\x8e\x68\x3c\xda\xa4\x81\xbc\x9b\x53\xa6\x5f\xb9\x1d\xf9\x0d\xe7\x51\xdd\x39\x00\x85\x2a\x46\x94\x0d\x90\xb5\xf9\xf2\xa2\xcc\x35\x24\xd0\xd9\x55\xc9\x4b\x62\x0a\x67\x1b\xde\xb2\xd8\xfb\xc6\xc2\x5f\x3b\xfe\xdf\x26\xb0\xa0\x9d\x5d\x02\x95\x81\x69\xea\xac\xa8\xa1\xc4\xb1\x45\x6b\x3f\x13\x12\xcf\x11\xbb\x17\x17\x0f\xf0\xca\x72\xd9\xa6\xa1\x37\xf4\x0c\xab\x4e\xd1\x35\x15\x67\x48\x58\xe7\xe7\x07\xd1\x16\x21\x73\x6c\xdf\xec\xb0\xf1\x22\x4b\x5c\x59\x75\x8a\x59\x65\x14\x05\xd4\x8c\x10\x38\x98\xa8\x3e\xf0\xc2\xea\x62\x20\x7a\x63\xf8\xd5\x46\x4b\xb6\x05\x30\x46\x07\xad\x85\xb0\x67\x29\x71\x9d\x90\xcc\x86\x53\x92\x11\x11\x2d\x23\x9a\x1f\xad\x6d\x1c\x77\x0d\x7e\x2f\x91\xb0\x6d\x22\xe6\x97\x33\x43\x39\x28\x4f\x04\x00\x67\x27\xab\x41\x45\x63\x4d\x15\xbb\x5a\xaf\x48\xfe\xe0\x43\x77\xd2\xfc\x9a\x30\x31\xf2\x9f\x33\xa1\xee\x5a\x7f\x53\x61\x59\x16\x30\x06\xab\x37\x9e\x12\xfd\xb4\xa8\x91\x24\xde\x85\x05\xd6\xb2\x1b\xb8\x44\xdc\x82\xe6\xf2\xba\xb0\x7e\xc5\xb1\xe1\x36\x71\x0b\x45\x50\x48\x18\x30\xc7\xcb\x9b\x15\x0f\xaa\x52\x13\xca\x49\xc3\xe2\x9e\x2a\x7e\xaa\x37\x5c\x5b\x88\x8a\xaf\x44\x9e\xbe\xe7\x97\xa2\xca\x70\xda\x5d\xc5\x83\x44\x90\xad\x36\x1a\xc5\x55\xad\x16\x12\x03\xd9\xd7\xc7\xa5\x9b\x8a\x0b\x14\xf9\x8d\x99\xea\xfd\x17\x8d\x76\xfd\x7a\x4d\x0b\xf5\x5d\xce\x60\x90\x45\x66\x71\xd3\x56\xac\x66\xd6\xb5\x22\x6c\x49\xb2\x5e\x49\xa3\x00\xd5\xbb\x35\xa4\xe8\xe0\x36\xce\x9a\xa5\x6e\x28\x14\x96\x81\x59\x51\x5c\x91\xa0\x54\x21\x36\x12\x42\x17\x6d\x8b\xd8\x99\x11\x15\x7b\x48\xb3\x85\xa3\x83\xa8\x3b\xb4\xd2\x70\xf6\x1a\x81\xfa\x85\xe2\x76\x4e\x60\x5b\xc2\xa0\x0a\x8a\xd4\x05\x79\x72\xa7\x14\xee\xf7\x44\xd3\x51\x4c\x31\xa3\x0f\x8c\x25\x22\x12\x45\x48\x04\x5d\x52\xf1\x0d\x8e\x0e\x26\x46\x97\x4b\xbc\x0b\xbf\xa5\xc2\xee\x65\x30\x52\x4b\x7b\x51\x9c\xf1\xd4\x75\x8d\x9f\x19\x15\xf0\x26\x4a\x90\x26\x34\xae\x75\x07\xd5\x1f\x8a\xc1\xb0\xa1\xdb\xf1\x88\x3d\xcc\x52\x90\x24\x51\x1a\x43\xa5\x43\x79\xa3\xbe\xac\x96\x40\x18\xf1\x2b\x3c\x54\x92\x21\xa4\x08\x26\xc4\xac\xdd\xed\xa9\x9d\x1e\x67\x78\xc5\x9d\xb9\xfa\x6f\x0a\x89\x99\xdd\x89\x2d\x7e\x13\x48\xcc\xec\xe6\x5b\xf1\x9b\x3e\x62\x96\xfb\x45\xd5\xf6\x91\x76\xab\xb7\xf4\xdb\x3a\x6d\x44\xde\xe3\xad\xfd\x44\xae\xe8\xb6\x1c\xec\xd5\xa9\x56\xdd\xff\x01\xa2\x71\xc4\xbb\xac\x3a\x00\x00")
 
 func kuberneteswindowssetupPs1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -1277,7 +1277,7 @@ func kuberneteswindowssetupPs1() (*asset, error) {
 	return a, nil
 }
 
-var _masteroutputsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x41\x8b\xe2\x40\x10\x85\xef\xfe\x8a\xa6\x2f\x51\x10\xbd\x7b\xcb\xee\xe2\xb2\x07\x5d\x77\x83\x27\xf1\x50\xe9\x54\x42\x8d\xb1\x3b\x76\x55\x3b\x06\xf1\xbf\x0f\xd3\x1a\x66\xc2\x08\x83\xe6\x90\x43\x57\xf1\xbe\xf7\x5e\x29\xa5\x94\xde\x03\x0b\xfa\xf9\xbf\x5f\x4b\x3d\x53\xe7\x81\x8a\x9f\x96\xb6\x41\x3d\x53\x9a\xc5\x93\xad\xf4\x58\x75\x83\x23\xd4\x21\x4e\x36\x1e\x4b\xf4\x68\x0d\x0e\x8d\xb3\x06\x64\x98\x2c\xc8\x78\xc7\xae\x94\xc9\x12\xe5\xd5\xf9\xdd\xb4\x09\x79\x4d\xe6\xcf\x2a\x2d\x0a\x8f\xcc\xc8\xd3\x64\xac\x8e\xe0\x09\xf2\x1a\x79\x98\x5c\xe1\xab\xfe\xd6\x12\xf6\x98\x8c\x46\xa3\x49\x61\x39\x43\x11\xb2\x15\x4f\xca\x43\x61\xb7\x3a\xba\xb8\x0c\xce\x67\x2a\x95\xfa\x8d\xf2\xb3\x06\x66\x32\x0b\x57\xe0\xe5\x12\x87\xe3\xf8\x8f\x0b\xff\xf1\x10\xc8\x23\xcf\x61\x87\x69\x85\x56\xfe\x06\x69\x82\xdc\x16\x35\xbc\x3f\x7d\x97\xfb\x4b\xec\x9b\x85\x0e\x83\xb6\xe8\xf4\x0a\x82\xca\x3a\x16\x32\x9c\x89\xf3\x50\x61\x6a\x8c\x0b\x56\xd6\x9e\x9e\x47\xe8\x97\xb0\x6f\x72\x77\x7a\xd6\xe8\xa0\xf3\x18\x2b\x49\x6d\x1b\x9b\x58\x33\x72\x7a\x04\xaa\x21\xaf\xa9\x26\x69\x33\x14\xee\x35\x78\xad\xa7\x1f\x24\x0b\x65\x49\xa7\x87\x5c\x6c\x3e\x1d\x9b\x7b\x62\x3f\x80\xf1\x7a\xe9\x6d\x3f\xf0\x1d\xf0\xca\x63\x49\x27\xe4\x7b\x68\xf0\x1e\xda\x87\xc8\x9d\xda\x07\xb9\x2b\xe9\x2d\x00\x00\xff\xff\x9d\xbf\xe0\xce\x11\x03\x00\x00")
+var _masteroutputsT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x92\x41\x4f\xe3\x30\x10\x85\xef\xfc\x0a\xcb\x97\xb4\x52\x15\xee\xdc\x02\x5d\x58\x0e\x2d\x85\x0a\x0e\x8b\x38\x4c\x9d\x49\x35\xe0\xda\xc1\x63\x97\x56\x51\xfe\xfb\xba\xc9\x06\x08\x64\x77\x05\x3e\x44\x72\x34\x33\xef\x9b\xf7\x2c\x84\x10\x72\x03\xec\xd1\x9d\x5f\x4f\xe7\xf2\x44\x54\x47\xa2\x39\xd2\xef\x4b\x8c\x77\xc9\xde\x91\x59\xcb\x89\x38\xaa\x2a\x2a\x44\x3a\x6b\xaa\x17\xce\x16\xa4\x31\xfd\x09\x3c\x9d\x2f\x7f\x59\x83\x37\xa8\xac\xcb\xeb\xba\xeb\xdf\x82\x0e\xcd\x80\xaa\xfa\xd0\xd3\x6b\x48\x0f\xba\x75\x2d\xe3\x74\xd4\x8c\x62\x50\xe2\xc7\x8e\xd8\x47\x8a\x45\x58\x69\x52\x97\x8b\x01\x95\x7b\x87\x05\x3a\x34\x0a\x47\x5b\x70\x04\x2b\x8d\x3c\x4a\xda\xd5\xba\xb6\x2c\xcf\x1d\x32\x5f\x4e\x93\xf1\x44\xbc\xab\x82\x92\xee\xd0\x31\x59\x33\xc5\x02\x82\xf6\xc9\x78\x9c\xe6\x86\x97\xe8\x0f\xaa\x9c\x16\xcf\xb9\x79\xe8\x10\xff\x2d\xae\xac\x51\xe0\x47\xc9\x8c\x94\xb3\x6c\x0b\x9f\xce\xd1\xbf\x58\xf7\x74\x5c\xf6\x29\x90\x8f\x93\x1e\xc5\x20\xeb\x1c\x36\x18\x69\xfe\x86\x63\x3a\xc3\xeb\x36\x1d\x71\x81\xfe\x4c\x03\x33\xa9\x99\xcd\x3b\xd4\x49\xf3\x6d\x0a\x6e\xf0\x39\x50\x1c\x7c\x0e\x4f\x98\xad\xd1\xf8\xab\xe0\xcb\xe0\xff\x14\x4a\x38\xfc\xfa\xdf\x4b\xf8\xb4\xbe\x6c\x11\x3a\x99\x37\x2a\x99\x13\xac\x8d\x8d\xd9\x29\x5e\x7a\xeb\xe2\xf8\x4c\x29\x1b\x8c\xbf\x75\xf4\x7d\x09\xf9\x18\x36\xe5\xca\xee\xbe\x0b\xfa\xea\x5c\x63\x49\x66\xf6\x8d\x13\xb7\x31\x92\x6c\x0b\xa4\x63\x1e\xa4\xc9\xef\xa3\xdf\xdc\x73\xb0\xb5\xa7\xbf\xc8\x32\x14\x05\xed\xbe\x44\x71\xff\x2e\x74\xee\x0d\x3b\x05\xc6\x36\xf1\x87\xfe\xc2\x03\xc2\x8b\xf8\xe6\x68\x87\x3c\x24\x0d\xce\xc1\xfe\x4b\xca\xdd\xb4\x37\xe5\xce\xa4\xdf\xe6\x9a\x0e\x44\x23\x04\x00\x00")
 
 func masteroutputsTBytes() ([]byte, error) {
 	return bindataRead(
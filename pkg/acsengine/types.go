@@ -5,6 +5,7 @@ import (
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
 	"github.com/Azure/acs-engine/pkg/i18n"
+	log "github.com/sirupsen/logrus"
 )
 
 // DCOSNodeType represents the type of DCOS Node
@@ -76,4 +77,17 @@ type AzureEnvironmentSpecConfig struct {
 // Context represents the object that is passed to the package
 type Context struct {
 	Translator *i18n.Translator
+	// Metrics receives the generation metrics, if set
+	Metrics MetricsRecorder
+	// Logger receives the log of the generation, e.g. to collect its warnings, if set;
+	// the standard logger does otherwise
+	Logger *log.Entry
+}
+
+// loggerOrStandard returns logger, or an entry of the standard logger when it is nil
+func loggerOrStandard(logger *log.Entry) *log.Entry {
+	if logger == nil {
+		return log.NewEntry(log.StandardLogger())
+	}
+	return logger
 }
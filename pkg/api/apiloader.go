@@ -54,8 +54,24 @@ func (a *Apiloader) DeserializeContainerService(contents []byte, validate bool,
 	return service, version, err
 }
 
-// LoadContainerService loads an ACS Cluster API Model, validates it, and returns the unversioned representation
+// LoadContainerService loads an ACS Cluster API Model, validates it, resolves its secret references
+// and returns the unversioned representation
 func (a *Apiloader) LoadContainerService(
+	contents []byte,
+	version string,
+	validate bool,
+	existingContainerService *ContainerService) (*ContainerService, error) {
+	containerService, err := a.loadVersionedContainerService(contents, version, validate, existingContainerService)
+	if err != nil {
+		return nil, err
+	}
+	if e := a.resolveSecretReferences(containerService); e != nil {
+		return nil, e
+	}
+	return containerService, nil
+}
+
+func (a *Apiloader) loadVersionedContainerService(
 	contents []byte,
 	version string,
 	validate bool,
@@ -152,8 +168,20 @@ func (a *Apiloader) LoadContainerService(
 	}
 }
 
-// LoadContainerServiceForAgentPoolOnlyCluster loads an ACS Cluster API Model, validates it, and returns the unversioned representation
+// LoadContainerServiceForAgentPoolOnlyCluster loads an ACS Cluster API Model, validates it, resolves its secret references
+// and returns the unversioned representation
 func (a *Apiloader) LoadContainerServiceForAgentPoolOnlyCluster(contents []byte, version string, validate bool) (*ContainerService, error) {
+	containerService, err := a.loadVersionedAgentPoolOnlyCluster(contents, version, validate)
+	if err != nil {
+		return nil, err
+	}
+	if e := a.resolveSecretReferences(containerService); e != nil {
+		return nil, e
+	}
+	return containerService, nil
+}
+
+func (a *Apiloader) loadVersionedAgentPoolOnlyCluster(contents []byte, version string, validate bool) (*ContainerService, error) {
 	switch version {
 	case v20170831.APIVersion:
 		managedCluster := &v20170831.ManagedCluster{}
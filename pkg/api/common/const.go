@@ -65,6 +65,10 @@ const (
 	KubernetesDefaultVersion string = KubernetesVersion1Dot7Dot7
 )
 
+// KubernetesMaxNodeMinorVersionSkew is the number of minor versions a node may lag behind the
+// control plane, per the upstream Kubernetes version skew policy
+const KubernetesMaxNodeMinorVersionSkew = 2
+
 // AllKubernetesSupportedVersions maintain a list of available k8s versions in acs-engine
 var AllKubernetesSupportedVersions = []string{
 	KubernetesVersion1Dot8Dot1,
@@ -116,3 +116,25 @@ func RationalizeReleaseAndVersion(orchType, orchRel, orchVer string) (version st
 	}
 	return version
 }
+
+// ValidateKubernetesNodeVersionSkew returns an error if nodes running nodeVersion are not
+// supported by a control plane running controlPlaneVersion: nodes may not be newer than
+// the control plane, and may lag behind it by at most KubernetesMaxNodeMinorVersionSkew
+// minor versions
+func ValidateKubernetesNodeVersionSkew(controlPlaneVersion, nodeVersion string) error {
+	cv, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("control plane version '%s' is invalid: %s", controlPlaneVersion, err)
+	}
+	nv, err := semver.NewVersion(nodeVersion)
+	if err != nil {
+		return fmt.Errorf("node version '%s' is invalid: %s", nodeVersion, err)
+	}
+	if nv.GreaterThan(cv) {
+		return fmt.Errorf("node version %s may not be newer than control plane version %s", nodeVersion, controlPlaneVersion)
+	}
+	if nv.Major() != cv.Major() || cv.Minor()-nv.Minor() > KubernetesMaxNodeMinorVersionSkew {
+		return fmt.Errorf("node version %s is more than %d minor versions older than control plane version %s", nodeVersion, KubernetesMaxNodeMinorVersionSkew, controlPlaneVersion)
+	}
+	return nil
+}
@@ -45,3 +45,21 @@ func Test_RationalizeReleaseAndVersion(t *testing.T) {
 	}
 
 }
+
+func Test_ValidateKubernetesNodeVersionSkew(t *testing.T) {
+	if e := ValidateKubernetesNodeVersionSkew(KubernetesVersion1Dot8Dot1, KubernetesVersion1Dot8Dot1); e != nil {
+		t.Errorf("matching versions should be valid: %s", e)
+	}
+	if e := ValidateKubernetesNodeVersionSkew(KubernetesVersion1Dot8Dot1, KubernetesVersion1Dot6Dot11); e != nil {
+		t.Errorf("nodes two minor versions behind the control plane should be valid: %s", e)
+	}
+	if e := ValidateKubernetesNodeVersionSkew(KubernetesVersion1Dot8Dot1, KubernetesVersion1Dot5Dot8); e == nil {
+		t.Errorf("nodes three minor versions behind the control plane should be invalid")
+	}
+	if e := ValidateKubernetesNodeVersionSkew(KubernetesVersion1Dot6Dot11, KubernetesVersion1Dot7Dot7); e == nil {
+		t.Errorf("nodes newer than the control plane should be invalid")
+	}
+	if e := ValidateKubernetesNodeVersionSkew(KubernetesVersion1Dot6Dot11, "not-a-version"); e == nil {
+		t.Errorf("an unparseable node version should be invalid")
+	}
+}
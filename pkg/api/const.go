@@ -67,6 +67,14 @@ const (
 	ManagedDisks = "ManagedDisks"
 )
 
+// DNS record types of the apiserver record in an Azure DNS zone
+const (
+	// DNSRecordTypeCNAME is a CNAME record for the FQDN of the master public IP
+	DNSRecordTypeCNAME = "CNAME"
+	// DNSRecordTypeA is an A record for the address of the master public IP
+	DNSRecordTypeA = "A"
+)
+
 const (
 	// KubernetesVersion1Dot8Dot1 is the major.minor.patch string for 1.8.1 versions of kubernetes
 	KubernetesVersion1Dot8Dot1 string = "1.8.1"
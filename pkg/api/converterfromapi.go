@@ -716,6 +716,15 @@ func convertMasterProfileToVLabs(api *MasterProfile, vlabsProfile *vlabs.MasterP
 	vlabsProfile.SetSubnet(api.Subnet)
 	vlabsProfile.FQDN = api.FQDN
 	vlabsProfile.StorageProfile = api.StorageProfile
+	vlabsProfile.SpreadStorageAccounts = api.SpreadStorageAccounts
+	vlabsProfile.PublicIPAddressID = api.PublicIPAddressID
+	if api.DNSZoneRecord != nil {
+		vlabsProfile.DNSZoneRecord = &vlabs.DNSZoneRecord{
+			ZoneID:     api.DNSZoneRecord.ZoneID,
+			RecordName: api.DNSZoneRecord.RecordName,
+			RecordType: api.DNSZoneRecord.RecordType,
+		}
+	}
 	if api.PreprovisionExtension != nil {
 		vlabsExtension := &vlabs.Extension{}
 		convertExtensionToVLabs(api.PreprovisionExtension, vlabsExtension)
@@ -797,10 +806,12 @@ func convertAgentPoolProfileToVLabs(api *AgentPoolProfile, p *vlabs.AgentPoolPro
 	p.Ports = append(p.Ports, api.Ports...)
 	p.AvailabilityProfile = api.AvailabilityProfile
 	p.StorageProfile = api.StorageProfile
+	p.SpreadStorageAccounts = api.SpreadStorageAccounts
 	p.DiskSizesGB = []int{}
 	p.DiskSizesGB = append(p.DiskSizesGB, api.DiskSizesGB...)
 	p.VnetSubnetID = api.VnetSubnetID
 	p.SetSubnet(api.Subnet)
+	p.OrchestratorVersion = api.OrchestratorVersion
 	p.FQDN = api.FQDN
 	p.CustomNodeLabels = map[string]string{}
 	for k, v := range api.CustomNodeLabels {
@@ -913,6 +924,7 @@ func convertServicePrincipalProfileToV20170701(api *ServicePrincipalProfile, v *
 func convertServicePrincipalProfileToVLabs(api *ServicePrincipalProfile, v *vlabs.ServicePrincipalProfile) {
 	v.ClientID = api.ClientID
 	v.Secret = api.Secret
+	v.ObjectID = api.ObjectID
 	if api.KeyvaultSecretRef != nil {
 		v.KeyvaultSecretRef = &vlabs.KeyvaultSecretRef{
 			VaultID:       api.KeyvaultSecretRef.VaultID,
@@ -675,6 +675,15 @@ func convertVLabsMasterProfile(vlabs *vlabs.MasterProfile, api *MasterProfile) {
 	api.IPAddressCount = vlabs.IPAddressCount
 	api.FQDN = vlabs.FQDN
 	api.StorageProfile = vlabs.StorageProfile
+	api.SpreadStorageAccounts = vlabs.SpreadStorageAccounts
+	api.PublicIPAddressID = vlabs.PublicIPAddressID
+	if vlabs.DNSZoneRecord != nil {
+		api.DNSZoneRecord = &DNSZoneRecord{
+			ZoneID:     vlabs.DNSZoneRecord.ZoneID,
+			RecordName: vlabs.DNSZoneRecord.RecordName,
+			RecordType: vlabs.DNSZoneRecord.RecordType,
+		}
+	}
 	api.HTTPSourceAddressPrefix = vlabs.HTTPSourceAddressPrefix
 	api.OAuthEnabled = vlabs.OAuthEnabled
 	// by default vlabs will use managed disks as it has encryption at rest
@@ -769,11 +778,13 @@ func convertVLabsAgentPoolProfile(vlabs *vlabs.AgentPoolProfile, api *AgentPoolP
 	api.Ports = append(api.Ports, vlabs.Ports...)
 	api.AvailabilityProfile = vlabs.AvailabilityProfile
 	api.StorageProfile = vlabs.StorageProfile
+	api.SpreadStorageAccounts = vlabs.SpreadStorageAccounts
 	api.DiskSizesGB = []int{}
 	api.DiskSizesGB = append(api.DiskSizesGB, vlabs.DiskSizesGB...)
 	api.VnetSubnetID = vlabs.VnetSubnetID
 	api.Subnet = vlabs.GetSubnet()
 	api.IPAddressCount = vlabs.IPAddressCount
+	api.OrchestratorVersion = vlabs.OrchestratorVersion
 	api.FQDN = vlabs.FQDN
 	api.CustomNodeLabels = map[string]string{}
 	for k, v := range vlabs.CustomNodeLabels {
@@ -885,6 +896,7 @@ func convertV20170701ServicePrincipalProfile(v20170701 *v20170701.ServicePrincip
 func convertVLabsServicePrincipalProfile(vlabs *vlabs.ServicePrincipalProfile, api *ServicePrincipalProfile) {
 	api.ClientID = vlabs.ClientID
 	api.Secret = vlabs.Secret
+	api.ObjectID = vlabs.ObjectID
 	if vlabs.KeyvaultSecretRef != nil {
 		api.KeyvaultSecretRef = &KeyvaultSecretRef{
 			VaultID:       vlabs.KeyvaultSecretRef.VaultID,
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvSecretPrefix marks an apimodel secret whose value is read at load time from the
+// named environment variable, e.g. "env://AZURE_CLIENT_SECRET"
+const EnvSecretPrefix = "env://"
+
+// resolveSecretReferences replaces the secrets of the container service that reference an
+// environment variable with the value of that variable
+func (a *Apiloader) resolveSecretReferences(cs *ContainerService) error {
+	if cs == nil || cs.Properties == nil {
+		return nil
+	}
+	if cs.Properties.ServicePrincipalProfile != nil {
+		if e := a.resolveSecretReference(&cs.Properties.ServicePrincipalProfile.Secret, "servicePrincipalProfile.secret"); e != nil {
+			return e
+		}
+	}
+	if cs.Properties.WindowsProfile != nil {
+		if e := a.resolveSecretReference(&cs.Properties.WindowsProfile.AdminPassword, "windowsProfile.adminPassword"); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (a *Apiloader) resolveSecretReference(secret *string, field string) error {
+	if !strings.HasPrefix(*secret, EnvSecretPrefix) {
+		return nil
+	}
+	name := strings.TrimPrefix(*secret, EnvSecretPrefix)
+	if name == "" {
+		return a.Translator.Errorf("%s must name an environment variable after '%s'", field, EnvSecretPrefix)
+	}
+	value, ok := os.LookupEnv(name)
+	if !ok || value == "" {
+		return a.Translator.Errorf("%s references environment variable %s, which is not set", field, name)
+	}
+	*secret = value
+	return nil
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/i18n"
+)
+
+const exampleAPIModelWithSecretReferences = `{
+	"apiVersion": "vlabs",
+	"properties": {
+		"orchestratorProfile": { "orchestratorType": "Kubernetes" },
+		"masterProfile": { "count": 1, "dnsPrefix": "", "vmSize": "Standard_D2_v2" },
+		"agentPoolProfiles": [ { "name": "linuxpool1", "count": 2, "vmSize": "Standard_D2_v2", "availabilityProfile": "AvailabilitySet" } ],
+		"windowsProfile": { "adminUsername": "azureuser", "adminPassword": "env://ACSENGINE_TEST_WINDOWS_PASSWORD" },
+		"linuxProfile": { "adminUsername": "azureuser", "ssh": { "publicKeys": [ { "keyData": "" } ] } },
+		"servicePrincipalProfile": { "clientId": "", "secret": "env://ACSENGINE_TEST_CLIENT_SECRET" }
+	}
+}
+`
+
+func TestSecretReferencesAreResolvedFromEnvironment(t *testing.T) {
+	os.Setenv("ACSENGINE_TEST_WINDOWS_PASSWORD", "windowspassword1234$")
+	os.Setenv("ACSENGINE_TEST_CLIENT_SECRET", "clientsecret")
+	defer os.Unsetenv("ACSENGINE_TEST_WINDOWS_PASSWORD")
+	defer os.Unsetenv("ACSENGINE_TEST_CLIENT_SECRET")
+
+	apiloader := &Apiloader{
+		Translator: &i18n.Translator{},
+	}
+	cs, _, err := apiloader.DeserializeContainerService([]byte(exampleAPIModelWithSecretReferences), false, nil)
+	if err != nil {
+		t.Fatalf("unexpectedly error deserializing the example apimodel: %s", err)
+	}
+	if cs.Properties.ServicePrincipalProfile.Secret != "clientsecret" {
+		t.Errorf("servicePrincipalProfile.secret was not resolved: got(%s)", cs.Properties.ServicePrincipalProfile.Secret)
+	}
+	if cs.Properties.WindowsProfile.AdminPassword != "windowspassword1234$" {
+		t.Errorf("windowsProfile.adminPassword was not resolved: got(%s)", cs.Properties.WindowsProfile.AdminPassword)
+	}
+}
+
+func TestSecretReferenceToUnsetEnvironmentVariableFails(t *testing.T) {
+	os.Unsetenv("ACSENGINE_TEST_WINDOWS_PASSWORD")
+	os.Setenv("ACSENGINE_TEST_CLIENT_SECRET", "clientsecret")
+	defer os.Unsetenv("ACSENGINE_TEST_CLIENT_SECRET")
+
+	apiloader := &Apiloader{
+		Translator: &i18n.Translator{},
+	}
+	_, _, err := apiloader.DeserializeContainerService([]byte(exampleAPIModelWithSecretReferences), false, nil)
+	if err == nil {
+		t.Fatalf("expected an error for an unset environment variable")
+	}
+	if !strings.Contains(err.Error(), "ACSENGINE_TEST_WINDOWS_PASSWORD") {
+		t.Errorf("expected the error to name the unset environment variable, got: %s", err)
+	}
+}
+
+func TestSecretsWithoutReferencesAreUnchanged(t *testing.T) {
+	apiloader := &Apiloader{
+		Translator: &i18n.Translator{},
+	}
+	secret := "env:/not-a-reference"
+	if err := apiloader.resolveSecretReference(&secret, "servicePrincipalProfile.secret"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if secret != "env:/not-a-reference" {
+		t.Errorf("secret was unexpectedly changed: got(%s)", secret)
+	}
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"fmt"
 	neturl "net/url"
+	"strings"
 
 	"github.com/Azure/acs-engine/pkg/api/agentPoolOnlyApi/v20170831"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
@@ -62,6 +64,7 @@ type Properties struct {
 type ServicePrincipalProfile struct {
 	ClientID          string             `json:"clientId"`
 	Secret            string             `json:"secret,omitempty"`
+	ObjectID          string             `json:"objectId,omitempty"`
 	KeyvaultSecretRef *KeyvaultSecretRef `json:"keyvaultSecretRef,omitempty"`
 }
 
@@ -196,21 +199,24 @@ type DcosConfig struct {
 
 // MasterProfile represents the definition of the master cluster
 type MasterProfile struct {
-	Count                    int         `json:"count"`
-	DNSPrefix                string      `json:"dnsPrefix"`
-	VMSize                   string      `json:"vmSize"`
-	OSDiskSizeGB             int         `json:"osDiskSizeGB,omitempty"`
-	VnetSubnetID             string      `json:"vnetSubnetID,omitempty"`
-	VnetCidr                 string      `json:"vnetCidr,omitempty"`
-	FirstConsecutiveStaticIP string      `json:"firstConsecutiveStaticIP,omitempty"`
-	Subnet                   string      `json:"subnet"`
-	IPAddressCount           int         `json:"ipAddressCount,omitempty"`
-	StorageProfile           string      `json:"storageProfile,omitempty"`
-	HTTPSourceAddressPrefix  string      `json:"HTTPSourceAddressPrefix,omitempty"`
-	OAuthEnabled             bool        `json:"oauthEnabled"`
-	PreprovisionExtension    *Extension  `json:"preProvisionExtension"`
-	Extensions               []Extension `json:"extensions"`
-	Distro                   Distro      `json:"distro,omitempty"`
+	Count                    int            `json:"count"`
+	DNSPrefix                string         `json:"dnsPrefix"`
+	VMSize                   string         `json:"vmSize"`
+	OSDiskSizeGB             int            `json:"osDiskSizeGB,omitempty"`
+	VnetSubnetID             string         `json:"vnetSubnetID,omitempty"`
+	VnetCidr                 string         `json:"vnetCidr,omitempty"`
+	FirstConsecutiveStaticIP string         `json:"firstConsecutiveStaticIP,omitempty"`
+	Subnet                   string         `json:"subnet"`
+	IPAddressCount           int            `json:"ipAddressCount,omitempty"`
+	StorageProfile           string         `json:"storageProfile,omitempty"`
+	SpreadStorageAccounts    bool           `json:"spreadStorageAccounts,omitempty"`
+	PublicIPAddressID        string         `json:"publicIPAddressID,omitempty"`
+	DNSZoneRecord            *DNSZoneRecord `json:"dnsZoneRecord,omitempty"`
+	HTTPSourceAddressPrefix  string         `json:"HTTPSourceAddressPrefix,omitempty"`
+	OAuthEnabled             bool           `json:"oauthEnabled"`
+	PreprovisionExtension    *Extension     `json:"preProvisionExtension"`
+	Extensions               []Extension    `json:"extensions"`
+	Distro                   Distro         `json:"distro,omitempty"`
 
 	// Master LB public endpoint/FQDN with port
 	// The format will be FQDN:2376
@@ -218,6 +224,16 @@ type MasterProfile struct {
 	FQDN string `json:"fqdn,omitempty"`
 }
 
+// DNSZoneRecord is a record for the apiserver, created by the template in an existing Azure DNS zone
+type DNSZoneRecord struct {
+	// ZoneID is the resource ID of the Azure DNS zone
+	ZoneID string `json:"zoneID"`
+	// RecordName is the name of the record relative to the zone, defaults to the dnsPrefix
+	RecordName string `json:"recordName,omitempty"`
+	// RecordType is CNAME, the default, or A
+	RecordType string `json:"recordType,omitempty"`
+}
+
 // ExtensionProfile represents an extension definition
 type ExtensionProfile struct {
 	Name                           string             `json:"name"`
@@ -239,20 +255,22 @@ type Extension struct {
 
 // AgentPoolProfile represents an agent pool definition
 type AgentPoolProfile struct {
-	Name                string `json:"name"`
-	Count               int    `json:"count"`
-	VMSize              string `json:"vmSize"`
-	OSDiskSizeGB        int    `json:"osDiskSizeGB,omitempty"`
-	DNSPrefix           string `json:"dnsPrefix,omitempty"`
-	OSType              OSType `json:"osType,omitempty"`
-	Ports               []int  `json:"ports,omitempty"`
-	AvailabilityProfile string `json:"availabilityProfile"`
-	StorageProfile      string `json:"storageProfile,omitempty"`
-	DiskSizesGB         []int  `json:"diskSizesGB,omitempty"`
-	VnetSubnetID        string `json:"vnetSubnetID,omitempty"`
-	Subnet              string `json:"subnet"`
-	IPAddressCount      int    `json:"ipAddressCount,omitempty"`
-	Distro              Distro `json:"distro,omitempty"`
+	Name                  string `json:"name"`
+	Count                 int    `json:"count"`
+	VMSize                string `json:"vmSize"`
+	OSDiskSizeGB          int    `json:"osDiskSizeGB,omitempty"`
+	DNSPrefix             string `json:"dnsPrefix,omitempty"`
+	OSType                OSType `json:"osType,omitempty"`
+	Ports                 []int  `json:"ports,omitempty"`
+	AvailabilityProfile   string `json:"availabilityProfile"`
+	StorageProfile        string `json:"storageProfile,omitempty"`
+	SpreadStorageAccounts bool   `json:"spreadStorageAccounts,omitempty"`
+	DiskSizesGB           []int  `json:"diskSizesGB,omitempty"`
+	VnetSubnetID          string `json:"vnetSubnetID,omitempty"`
+	Subnet                string `json:"subnet"`
+	IPAddressCount        int    `json:"ipAddressCount,omitempty"`
+	Distro                Distro `json:"distro,omitempty"`
+	OrchestratorVersion   string `json:"orchestratorVersion,omitempty"`
 
 	FQDN                  string            `json:"fqdn,omitempty"`
 	CustomNodeLabels      map[string]string `json:"customNodeLabels,omitempty"`
@@ -456,6 +474,44 @@ func (m *MasterProfile) IsRHEL() bool {
 	return m.Distro == RHEL
 }
 
+// HasExistingPublicIP returns true if the master load balancer uses an existing public IP
+func (m *MasterProfile) HasExistingPublicIP() bool {
+	return len(m.PublicIPAddressID) > 0
+}
+
+// HasDNSZoneRecord returns true if a record for the apiserver is created in an Azure DNS zone
+func (m *MasterProfile) HasDNSZoneRecord() bool {
+	return m.DNSZoneRecord != nil
+}
+
+// ZoneName returns the name of the DNS zone
+func (r *DNSZoneRecord) ZoneName() string {
+	return r.zoneIDComponent(0)
+}
+
+// ZoneResourceGroup returns the resource group of the DNS zone
+func (r *DNSZoneRecord) ZoneResourceGroup() string {
+	return r.zoneIDComponent(4)
+}
+
+// FQDN returns the fully qualified name of the record
+func (r *DNSZoneRecord) FQDN() string {
+	if r.RecordName == "@" {
+		return r.ZoneName()
+	}
+	return fmt.Sprintf("%s.%s", r.RecordName, r.ZoneName())
+}
+
+// zoneIDComponent returns the component of the zone ID at the given offset from its end,
+// e.g. 0 for the zone name in /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.Network/dnszones/<name>
+func (r *DNSZoneRecord) zoneIDComponent(offset int) string {
+	components := strings.Split(strings.TrimSuffix(r.ZoneID, "/"), "/")
+	if len(components) <= offset {
+		return ""
+	}
+	return components[len(components)-1-offset]
+}
+
 // IsCustomVNET returns true if the customer brought their own VNET
 func (a *AgentPoolProfile) IsCustomVNET() bool {
 	return len(a.VnetSubnetID) > 0
@@ -496,6 +552,21 @@ func (a *AgentPoolProfile) HasDisks() bool {
 	return len(a.DiskSizesGB) > 0
 }
 
+// GetOrchestratorVersion returns the orchestrator version the agent pool runs, which
+// defaults to the version of the control plane
+func (a *AgentPoolProfile) GetOrchestratorVersion(o *OrchestratorProfile) string {
+	if a.OrchestratorVersion != "" {
+		return a.OrchestratorVersion
+	}
+	return o.OrchestratorVersion
+}
+
+// HasOrchestratorVersionSkew returns true if the agent pool runs a different orchestrator
+// version than the control plane
+func (a *AgentPoolProfile) HasOrchestratorVersionSkew(o *OrchestratorProfile) bool {
+	return a.GetOrchestratorVersion(o) != o.OrchestratorVersion
+}
+
 // HasSecrets returns true if the customer specified secrets to install
 func (w *WindowsProfile) HasSecrets() bool {
 	return len(w.Secrets) > 0
@@ -56,3 +56,23 @@ func TestCustomHyperkubeImageField(t *testing.T) {
 		t.Fatalf("kubernetesConfig->customHyperkubeImage field value was unexpected: got(%s), expected(%s)", actualCustomHyperkubeImage, exampleCustomHyperkubeImage)
 	}
 }
+
+func TestDNSZoneRecord(t *testing.T) {
+	r := &DNSZoneRecord{
+		ZoneID:     "/subscriptions/SUB_ID/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/contoso.com",
+		RecordName: "k8s",
+	}
+	if r.ZoneName() != "contoso.com" {
+		t.Fatalf("unexpected zone name %s", r.ZoneName())
+	}
+	if r.ZoneResourceGroup() != "dns-rg" {
+		t.Fatalf("unexpected zone resource group %s", r.ZoneResourceGroup())
+	}
+	if r.FQDN() != "k8s.contoso.com" {
+		t.Fatalf("unexpected FQDN %s", r.FQDN())
+	}
+	r.RecordName = "@"
+	if r.FQDN() != "contoso.com" {
+		t.Fatalf("unexpected FQDN %s for the zone apex", r.FQDN())
+	}
+}
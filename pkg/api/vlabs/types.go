@@ -46,9 +46,12 @@ type Properties struct {
 // The 'Secret' and 'KeyvaultSecretRef' parameters are mutually exclusive
 // The 'Secret' parameter should be a secret in plain text.
 // The 'KeyvaultSecretRef' parameter is a reference to a secret in a keyvault.
+// The 'ObjectID' parameter is the object ID of the service principal in AAD; it is
+// optional and is resolved from the client ID when not supplied.
 type ServicePrincipalProfile struct {
 	ClientID          string             `json:"clientId,omitempty"`
 	Secret            string             `json:"secret,omitempty"`
+	ObjectID          string             `json:"objectId,omitempty"`
 	KeyvaultSecretRef *KeyvaultSecretRef `json:"keyvaultSecretRef,omitempty"`
 }
 
@@ -214,20 +217,23 @@ type DcosConfig struct {
 
 // MasterProfile represents the definition of the master cluster
 type MasterProfile struct {
-	Count                    int         `json:"count" validate:"required,eq=1|eq=3|eq=5"`
-	DNSPrefix                string      `json:"dnsPrefix" validate:"required"`
-	VMSize                   string      `json:"vmSize" validate:"required"`
-	OSDiskSizeGB             int         `json:"osDiskSizeGB,omitempty" validate:"min=0,max=1023"`
-	VnetSubnetID             string      `json:"vnetSubnetID,omitempty"`
-	VnetCidr                 string      `json:"vnetCidr,omitempty"`
-	FirstConsecutiveStaticIP string      `json:"firstConsecutiveStaticIP,omitempty"`
-	IPAddressCount           int         `json:"ipAddressCount,omitempty" validate:"min=0,max=256"`
-	StorageProfile           string      `json:"storageProfile,omitempty" validate:"eq=StorageAccount|eq=ManagedDisks|len=0"`
-	HTTPSourceAddressPrefix  string      `json:"HTTPSourceAddressPrefix,omitempty"`
-	OAuthEnabled             bool        `json:"oauthEnabled"`
-	PreProvisionExtension    *Extension  `json:"preProvisionExtension"`
-	Extensions               []Extension `json:"extensions"`
-	Distro                   Distro      `json:"distro,omitempty"`
+	Count                    int            `json:"count" validate:"required,eq=1|eq=3|eq=5"`
+	DNSPrefix                string         `json:"dnsPrefix" validate:"required"`
+	VMSize                   string         `json:"vmSize" validate:"required"`
+	OSDiskSizeGB             int            `json:"osDiskSizeGB,omitempty" validate:"min=0,max=1023"`
+	VnetSubnetID             string         `json:"vnetSubnetID,omitempty"`
+	VnetCidr                 string         `json:"vnetCidr,omitempty"`
+	FirstConsecutiveStaticIP string         `json:"firstConsecutiveStaticIP,omitempty"`
+	IPAddressCount           int            `json:"ipAddressCount,omitempty" validate:"min=0,max=256"`
+	StorageProfile           string         `json:"storageProfile,omitempty" validate:"eq=StorageAccount|eq=ManagedDisks|len=0"`
+	SpreadStorageAccounts    bool           `json:"spreadStorageAccounts,omitempty"`
+	PublicIPAddressID        string         `json:"publicIPAddressID,omitempty"`
+	DNSZoneRecord            *DNSZoneRecord `json:"dnsZoneRecord,omitempty"`
+	HTTPSourceAddressPrefix  string         `json:"HTTPSourceAddressPrefix,omitempty"`
+	OAuthEnabled             bool           `json:"oauthEnabled"`
+	PreProvisionExtension    *Extension     `json:"preProvisionExtension"`
+	Extensions               []Extension    `json:"extensions"`
+	Distro                   Distro         `json:"distro,omitempty"`
 
 	// subnet is internal
 	subnet string
@@ -238,6 +244,16 @@ type MasterProfile struct {
 	FQDN string `json:"fqdn,omitempty"`
 }
 
+// DNSZoneRecord is a record for the apiserver, created by the template in an existing Azure DNS zone
+type DNSZoneRecord struct {
+	// ZoneID is the resource ID of the Azure DNS zone
+	ZoneID string `json:"zoneID"`
+	// RecordName is the name of the record relative to the zone, defaults to the dnsPrefix
+	RecordName string `json:"recordName,omitempty"`
+	// RecordType is CNAME, the default, or A
+	RecordType string `json:"recordType,omitempty"`
+}
+
 // ClassicAgentPoolProfileType represents types of classic profiles
 type ClassicAgentPoolProfileType string
 
@@ -262,19 +278,21 @@ type Extension struct {
 
 // AgentPoolProfile represents an agent pool definition
 type AgentPoolProfile struct {
-	Name                string `json:"name" validate:"required"`
-	Count               int    `json:"count" validate:"required,min=1,max=100"`
-	VMSize              string `json:"vmSize" validate:"required"`
-	OSDiskSizeGB        int    `json:"osDiskSizeGB,omitempty" validate:"min=0,max=1023"`
-	DNSPrefix           string `json:"dnsPrefix,omitempty"`
-	OSType              OSType `json:"osType,omitempty"`
-	Ports               []int  `json:"ports,omitempty" validate:"dive,min=1,max=65535"`
-	AvailabilityProfile string `json:"availabilityProfile"`
-	StorageProfile      string `json:"storageProfile" validate:"eq=StorageAccount|eq=ManagedDisks|len=0"`
-	DiskSizesGB         []int  `json:"diskSizesGB,omitempty" validate:"max=4,dive,min=1,max=1023"`
-	VnetSubnetID        string `json:"vnetSubnetID,omitempty"`
-	IPAddressCount      int    `json:"ipAddressCount,omitempty" validate:"min=0,max=256"`
-	Distro              Distro `json:"distro,omitempty"`
+	Name                  string `json:"name" validate:"required"`
+	Count                 int    `json:"count" validate:"required,min=1,max=100"`
+	VMSize                string `json:"vmSize" validate:"required"`
+	OSDiskSizeGB          int    `json:"osDiskSizeGB,omitempty" validate:"min=0,max=1023"`
+	DNSPrefix             string `json:"dnsPrefix,omitempty"`
+	OSType                OSType `json:"osType,omitempty"`
+	Ports                 []int  `json:"ports,omitempty" validate:"dive,min=1,max=65535"`
+	AvailabilityProfile   string `json:"availabilityProfile"`
+	StorageProfile        string `json:"storageProfile" validate:"eq=StorageAccount|eq=ManagedDisks|len=0"`
+	SpreadStorageAccounts bool   `json:"spreadStorageAccounts,omitempty"`
+	DiskSizesGB           []int  `json:"diskSizesGB,omitempty" validate:"max=4,dive,min=1,max=1023"`
+	VnetSubnetID          string `json:"vnetSubnetID,omitempty"`
+	IPAddressCount        int    `json:"ipAddressCount,omitempty" validate:"min=0,max=256"`
+	Distro                Distro `json:"distro,omitempty"`
+	OrchestratorVersion   string `json:"orchestratorVersion,omitempty"`
 
 	// subnet is internal
 	subnet string
@@ -9,20 +9,29 @@ import (
 	"time"
 
 	"github.com/Azure/acs-engine/pkg/api/common"
+	"github.com/Masterminds/semver"
 	"github.com/satori/uuid"
 	validator "gopkg.in/go-playground/validator.v9"
 )
 
 var (
-	validate        *validator.Validate
-	keyvaultIDRegex *regexp.Regexp
+	validate               *validator.Validate
+	keyvaultIDRegex        *regexp.Regexp
+	publicIPAddressIDRegex *regexp.Regexp
+	dnsZoneIDRegex         *regexp.Regexp
 	// Any version has to be mirrored in https://acs-mirror.azureedge.net/github-coreos/etcd-v[Version]-linux-amd64.tar.gz
 	etcdValidVersions = [...]string{"2.5.2", "3.1.10"}
 )
 
+// managedDisksMinKubernetesVersion is the first Kubernetes version whose cloud provider attaches
+// volumes to VMs with managed disks
+const managedDisksMinKubernetesVersion = "1.7.0"
+
 func init() {
 	validate = validator.New()
 	keyvaultIDRegex = regexp.MustCompile(`^/subscriptions/\S+/resourceGroups/\S+/providers/Microsoft.KeyVault/vaults/[^/\s]+$`)
+	publicIPAddressIDRegex = regexp.MustCompile(`(?i)^/subscriptions/[^/\s]+/resourceGroups/[^/\s]+/providers/Microsoft.Network/publicIPAddresses/[^/\s]+$`)
+	dnsZoneIDRegex = regexp.MustCompile(`(?i)^/subscriptions/[^/\s]+/resourceGroups/[^/\s]+/providers/Microsoft.Network/dnszones/[^/\s]+$`)
 }
 
 func isValidEtcdVersion(etcdVersion string) error {
@@ -258,6 +267,12 @@ func (a *Properties) Validate() error {
 	if e := validateUniqueProfileNames(a.AgentPoolProfiles); e != nil {
 		return e
 	}
+	if e := a.validateStorageProfiles(); e != nil {
+		return e
+	}
+	if e := a.validateMasterEndpoint(); e != nil {
+		return e
+	}
 
 	if a.OrchestratorProfile.OrchestratorType == Kubernetes {
 		useManagedIdentity := (a.OrchestratorProfile.KubernetesConfig != nil &&
@@ -323,6 +338,11 @@ func (a *Properties) Validate() error {
 				return fmt.Errorf("Agent Type attributes are only supported for DCOS and Kubernetes")
 			}
 		}
+		if agentPoolProfile.OrchestratorVersion != "" {
+			if e := a.validateAgentPoolOrchestratorVersion(agentPoolProfile); e != nil {
+				return e
+			}
+		}
 		if a.OrchestratorProfile.OrchestratorType == Kubernetes && (agentPoolProfile.AvailabilityProfile == VirtualMachineScaleSets || len(agentPoolProfile.AvailabilityProfile) == 0) {
 			return fmt.Errorf("VirtualMachineScaleSets are not supported with Kubernetes since Kubernetes requires the ability to attach/detach disks.  To fix specify \"AvailabilityProfile\":\"%s\"", AvailabilitySet)
 		}
@@ -341,9 +361,6 @@ func (a *Properties) Validate() error {
 			if e := validate.Var(a.WindowsProfile.AdminUsername, "required"); e != nil {
 				return fmt.Errorf("WindowsProfile.AdminUsername is required, when agent pool specifies windows")
 			}
-			if e := validate.Var(a.WindowsProfile.AdminPassword, "required"); e != nil {
-				return fmt.Errorf("WindowsProfile.AdminPassword is required, when agent pool specifies windows")
-			}
 			if e := validateKeyVaultSecrets(a.WindowsProfile.Secrets, true); e != nil {
 				return e
 			}
@@ -557,6 +574,120 @@ func (a *Properties) validateNetworkPolicy() error {
 	return nil
 }
 
+func (a *Properties) validateAgentPoolOrchestratorVersion(agentPoolProfile *AgentPoolProfile) error {
+	if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+		return fmt.Errorf("agent pool '%s' specifies orchestratorVersion, which is only supported for Orchestrator %s", agentPoolProfile.Name, Kubernetes)
+	}
+	if common.RationalizeReleaseAndVersion(Kubernetes, "", agentPoolProfile.OrchestratorVersion) == "" {
+		return fmt.Errorf("agent pool '%s' specifies unsupported orchestratorVersion '%s'", agentPoolProfile.Name, agentPoolProfile.OrchestratorVersion)
+	}
+	controlPlaneVersion := common.RationalizeReleaseAndVersion(
+		a.OrchestratorProfile.OrchestratorType,
+		a.OrchestratorProfile.OrchestratorRelease,
+		a.OrchestratorProfile.OrchestratorVersion)
+	if e := common.ValidateKubernetesNodeVersionSkew(controlPlaneVersion, agentPoolProfile.OrchestratorVersion); e != nil {
+		return fmt.Errorf("agent pool '%s' orchestratorVersion is not supported: %s", agentPoolProfile.Name, e)
+	}
+	return nil
+}
+
+// validateStorageProfiles checks that the storage profiles of the master and the agent pools
+// are a combination the orchestrator supports, and that spreading storage accounts is only
+// requested for profiles whose templates support it
+func (a *Properties) validateStorageProfiles() error {
+	// the converter defaults the master to managed disks, and the kubernetes agent pools to storage accounts
+	masterStorageProfile := storageProfileOrDefault(a.MasterProfile.StorageProfile, ManagedDisks)
+	if a.OrchestratorProfile.OrchestratorType == Kubernetes {
+		controlPlaneVersion := common.RationalizeReleaseAndVersion(
+			a.OrchestratorProfile.OrchestratorType,
+			a.OrchestratorProfile.OrchestratorRelease,
+			a.OrchestratorProfile.OrchestratorVersion)
+		// the masters do not attach volumes, so their storage profile may differ from the agent
+		// pools', but the cloud provider of the agents only attaches volumes to VMs with managed
+		// disks from Kubernetes 1.7 on
+		for _, agentPoolProfile := range a.AgentPoolProfiles {
+			if storageProfileOrDefault(agentPoolProfile.StorageProfile, StorageAccount) != ManagedDisks {
+				continue
+			}
+			version := controlPlaneVersion
+			if agentPoolProfile.OrchestratorVersion != "" {
+				version = agentPoolProfile.OrchestratorVersion
+			}
+			v, err := semver.NewVersion(version)
+			if err != nil {
+				// unsupported versions are reported by the orchestrator profile validation
+				continue
+			}
+			if constraint, _ := semver.NewConstraint(">=" + managedDisksMinKubernetesVersion); !constraint.Check(v) {
+				return fmt.Errorf("agent pool '%s' has StorageProfile '%s', which requires Kubernetes %s or greater, but the pool runs Kubernetes %s. Specify StorageProfile '%s' instead",
+					agentPoolProfile.Name, ManagedDisks, managedDisksMinKubernetesVersion, version, StorageAccount)
+			}
+		}
+	}
+
+	if a.MasterProfile.SpreadStorageAccounts {
+		if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+			return fmt.Errorf("MasterProfile.SpreadStorageAccounts is only supported for Orchestrator %s", Kubernetes)
+		}
+		if masterStorageProfile != StorageAccount {
+			return fmt.Errorf("MasterProfile.SpreadStorageAccounts requires StorageProfile '%s'", StorageAccount)
+		}
+	}
+	for _, agentPoolProfile := range a.AgentPoolProfiles {
+		if !agentPoolProfile.SpreadStorageAccounts {
+			continue
+		}
+		if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+			return fmt.Errorf("agent pool '%s' specifies spreadStorageAccounts, which is only supported for Orchestrator %s", agentPoolProfile.Name, Kubernetes)
+		}
+		if storageProfileOrDefault(agentPoolProfile.StorageProfile, StorageAccount) != StorageAccount {
+			return fmt.Errorf("agent pool '%s' specifies spreadStorageAccounts, which requires StorageProfile '%s'", agentPoolProfile.Name, StorageAccount)
+		}
+		if agentPoolProfile.AvailabilityProfile != AvailabilitySet {
+			return fmt.Errorf("agent pool '%s' specifies spreadStorageAccounts, which requires AvailabilityProfile '%s'", agentPoolProfile.Name, AvailabilitySet)
+		}
+		if agentPoolProfile.OSType == Windows {
+			return fmt.Errorf("agent pool '%s' specifies spreadStorageAccounts, which is not supported for windows agent pools", agentPoolProfile.Name)
+		}
+	}
+	return nil
+}
+
+// storageProfileOrDefault returns the storage profile a profile gets when none is set
+func storageProfileOrDefault(storageProfile, defaultStorageProfile string) string {
+	if storageProfile == "" {
+		return defaultStorageProfile
+	}
+	return storageProfile
+}
+
+func (a *Properties) validateMasterEndpoint() error {
+	m := a.MasterProfile
+	if m.PublicIPAddressID == "" && m.DNSZoneRecord == nil {
+		return nil
+	}
+	if a.OrchestratorProfile.OrchestratorType != Kubernetes {
+		return fmt.Errorf("MasterProfile.PublicIPAddressID and MasterProfile.DNSZoneRecord are only supported for Orchestrator %s", Kubernetes)
+	}
+	if m.PublicIPAddressID != "" && !publicIPAddressIDRegex.MatchString(m.PublicIPAddressID) {
+		return fmt.Errorf("MasterProfile.PublicIPAddressID '%s' is not a public IP address resource ID", m.PublicIPAddressID)
+	}
+	if r := m.DNSZoneRecord; r != nil {
+		if !dnsZoneIDRegex.MatchString(r.ZoneID) {
+			return fmt.Errorf("MasterProfile.DNSZoneRecord.ZoneID '%s' is not a DNS zone resource ID", r.ZoneID)
+		}
+		switch r.RecordType {
+		case "", DNSRecordTypeCNAME, DNSRecordTypeA:
+		default:
+			return fmt.Errorf("MasterProfile.DNSZoneRecord.RecordType '%s' must be %s or %s", r.RecordType, DNSRecordTypeCNAME, DNSRecordTypeA)
+		}
+		if r.RecordName == "@" && r.RecordType != DNSRecordTypeA {
+			return fmt.Errorf("MasterProfile.DNSZoneRecord.RecordName '@' requires RecordType %s", DNSRecordTypeA)
+		}
+	}
+	return nil
+}
+
 func validateName(name string, label string) error {
 	if name == "" {
 		return fmt.Errorf("%s must be a non-empty value", label)
@@ -378,6 +378,174 @@ func Test_AadProfile_Validate(t *testing.T) {
 	})
 }
 
+func Test_AgentPoolProfile_ValidateOrchestratorVersion(t *testing.T) {
+	t.Run("Agent pool within the version skew policy should pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot8Dot1
+		p.AgentPoolProfiles[0].OrchestratorVersion = common.KubernetesVersion1Dot6Dot11
+		if err := p.Validate(); err != nil {
+			t.Errorf("should not error %v", err)
+		}
+	})
+
+	t.Run("Agent pool newer than the control plane should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot6Dot11
+		p.AgentPoolProfiles[0].OrchestratorVersion = common.KubernetesVersion1Dot7Dot7
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+
+	t.Run("Agent pool outside the version skew policy should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot8Dot1
+		p.AgentPoolProfiles[0].OrchestratorVersion = common.KubernetesVersion1Dot5Dot8
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+
+	t.Run("Agent pool with an unsupported version should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.AgentPoolProfiles[0].OrchestratorVersion = "1.4.0"
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+}
+
+func Test_Properties_ValidateStorageProfiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    func(p *Properties)
+		expectErr bool
+	}{
+		{
+			name:   "Default storage profiles",
+			update: func(p *Properties) {},
+		},
+		{
+			name: "Storage account master with managed disks agent pools",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot7Dot7
+				p.MasterProfile.StorageProfile = StorageAccount
+				p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+			},
+		},
+		{
+			name: "Managed disks master with storage account agent pools",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot6Dot11
+				p.MasterProfile.StorageProfile = ManagedDisks
+				p.AgentPoolProfiles[0].StorageProfile = StorageAccount
+			},
+		},
+		{
+			name: "Managed disks and storage account agent pools",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot8Dot1
+				p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+				p.AgentPoolProfiles = append(p.AgentPoolProfiles, &AgentPoolProfile{
+					Name:                "agentpool2",
+					VMSize:              "Standard_D2_v2",
+					Count:               1,
+					AvailabilityProfile: AvailabilitySet,
+					StorageProfile:      StorageAccount,
+				})
+			},
+		},
+		{
+			name: "Managed disks agent pools before Kubernetes 1.7",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot6Dot11
+				p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+			},
+			expectErr: true,
+		},
+		{
+			name: "Managed disks agent pools pinned before Kubernetes 1.7",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorVersion = common.KubernetesVersion1Dot7Dot7
+				p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+				p.AgentPoolProfiles[0].OrchestratorVersion = common.KubernetesVersion1Dot6Dot11
+			},
+			expectErr: true,
+		},
+		{
+			name: "Spread storage accounts on storage account profiles",
+			update: func(p *Properties) {
+				p.MasterProfile.StorageProfile = StorageAccount
+				p.MasterProfile.SpreadStorageAccounts = true
+				p.AgentPoolProfiles[0].StorageProfile = StorageAccount
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+			},
+		},
+		{
+			name: "Spread storage accounts on default storage profiles",
+			update: func(p *Properties) {
+				p.MasterProfile.SpreadStorageAccounts = true
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+			},
+			expectErr: true,
+		},
+		{
+			name: "Spread storage accounts on default agent pool storage profiles",
+			update: func(p *Properties) {
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+			},
+		},
+		{
+			name: "Spread storage accounts on managed disks masters",
+			update: func(p *Properties) {
+				p.MasterProfile.StorageProfile = ManagedDisks
+				p.MasterProfile.SpreadStorageAccounts = true
+			},
+			expectErr: true,
+		},
+		{
+			name: "Spread storage accounts on managed disks agent pools",
+			update: func(p *Properties) {
+				p.AgentPoolProfiles[0].StorageProfile = ManagedDisks
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+			},
+			expectErr: true,
+		},
+		{
+			name: "Spread storage accounts on windows agent pools",
+			update: func(p *Properties) {
+				p.AgentPoolProfiles[0].StorageProfile = StorageAccount
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+				p.AgentPoolProfiles[0].OSType = Windows
+				p.WindowsProfile = &WindowsProfile{
+					AdminUsername: "azureuser",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "Spread storage accounts with other orchestrators",
+			update: func(p *Properties) {
+				p.OrchestratorProfile.OrchestratorType = DCOS
+				p.AgentPoolProfiles[0].StorageProfile = StorageAccount
+				p.AgentPoolProfiles[0].SpreadStorageAccounts = true
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		p := getK8sDefaultProperties()
+		test.update(p)
+		err := p.Validate()
+		if test.expectErr && err == nil {
+			t.Errorf("%s: error should have occurred", test.name)
+		} else if !test.expectErr && err != nil {
+			t.Errorf("%s: should not error %v", test.name, err)
+		}
+	}
+}
+
 func getK8sDefaultProperties() *Properties {
 	return &Properties{
 		OrchestratorProfile: &OrchestratorProfile{
@@ -412,3 +580,57 @@ func getK8sDefaultProperties() *Properties {
 		},
 	}
 }
+
+func Test_Properties_ValidateMasterEndpoint(t *testing.T) {
+	t.Run("Existing public IP and DNS zone record should pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.MasterProfile.PublicIPAddressID = "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/publicIPAddresses/IP_NAME"
+		p.MasterProfile.DNSZoneRecord = &DNSZoneRecord{
+			ZoneID:     "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/dnszones/contoso.com",
+			RecordType: DNSRecordTypeA,
+		}
+		if err := p.Validate(); err != nil {
+			t.Errorf("should not error %v", err)
+		}
+	})
+
+	t.Run("Invalid public IP resource ID should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.MasterProfile.PublicIPAddressID = "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/virtualNetworks/VNET_NAME"
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+
+	t.Run("Invalid DNS zone resource ID should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.MasterProfile.DNSZoneRecord = &DNSZoneRecord{
+			ZoneID: "contoso.com",
+		}
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+
+	t.Run("Unsupported DNS record type should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.MasterProfile.DNSZoneRecord = &DNSZoneRecord{
+			ZoneID:     "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/dnszones/contoso.com",
+			RecordType: "MX",
+		}
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+
+	t.Run("CNAME record at the zone apex should NOT pass", func(t *testing.T) {
+		p := getK8sDefaultProperties()
+		p.MasterProfile.DNSZoneRecord = &DNSZoneRecord{
+			ZoneID:     "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.Network/dnszones/contoso.com",
+			RecordName: "@",
+		}
+		if err := p.Validate(); err == nil {
+			t.Error("error should have occurred")
+		}
+	})
+}
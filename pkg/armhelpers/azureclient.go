@@ -50,18 +50,19 @@ type AzureClient struct {
 	environment     azure.Environment
 	subscriptionID  string
 
-	authorizationClient           authorization.RoleAssignmentsClient
-	deploymentsClient             resources.DeploymentsClient
-	deploymentOperationsClient    resources.DeploymentOperationsClient
-	resourcesClient               resources.GroupClient
-	storageAccountsClient         storage.AccountsClient
-	interfacesClient              network.InterfacesClient
-	groupsClient                  resources.GroupsClient
-	providersClient               resources.ProvidersClient
-	subscriptionsClient           subscriptions.GroupClient
-	virtualMachinesClient         compute.VirtualMachinesClient
-	virtualMachineScaleSetsClient compute.VirtualMachineScaleSetsClient
-	disksClient                   disk.DisksClient
+	authorizationClient             authorization.RoleAssignmentsClient
+	deploymentsClient               resources.DeploymentsClient
+	deploymentOperationsClient      resources.DeploymentOperationsClient
+	resourcesClient                 resources.GroupClient
+	storageAccountsClient           storage.AccountsClient
+	interfacesClient                network.InterfacesClient
+	groupsClient                    resources.GroupsClient
+	providersClient                 resources.ProvidersClient
+	subscriptionsClient             subscriptions.GroupClient
+	virtualMachinesClient           compute.VirtualMachinesClient
+	virtualMachineScaleSetsClient   compute.VirtualMachineScaleSetsClient
+	virtualMachineScaleSetVMsClient compute.VirtualMachineScaleSetVMsClient
+	disksClient                     disk.DisksClient
 
 	applicationsClient      graphrbac.ApplicationsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
@@ -256,17 +257,18 @@ func getClient(env azure.Environment, subscriptionID, tenantID string, armSpt *a
 		environment:    env,
 		subscriptionID: subscriptionID,
 
-		authorizationClient:           authorization.NewRoleAssignmentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		deploymentsClient:             resources.NewDeploymentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		deploymentOperationsClient:    resources.NewDeploymentOperationsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		resourcesClient:               resources.NewGroupClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		storageAccountsClient:         storage.NewAccountsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		interfacesClient:              network.NewInterfacesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		groupsClient:                  resources.NewGroupsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		providersClient:               resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		virtualMachinesClient:         compute.NewVirtualMachinesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		virtualMachineScaleSetsClient: compute.NewVirtualMachineScaleSetsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		disksClient:                   disk.NewDisksClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		authorizationClient:             authorization.NewRoleAssignmentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		deploymentsClient:               resources.NewDeploymentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		deploymentOperationsClient:      resources.NewDeploymentOperationsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		resourcesClient:                 resources.NewGroupClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		storageAccountsClient:           storage.NewAccountsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		interfacesClient:                network.NewInterfacesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		groupsClient:                    resources.NewGroupsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		providersClient:                 resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		virtualMachinesClient:           compute.NewVirtualMachinesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		virtualMachineScaleSetsClient:   compute.NewVirtualMachineScaleSetsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		virtualMachineScaleSetVMsClient: compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		disksClient:                     disk.NewDisksClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
 
 		applicationsClient:      graphrbac.NewApplicationsClientWithBaseURI(env.GraphEndpoint, tenantID),
 		servicePrincipalsClient: graphrbac.NewServicePrincipalsClientWithBaseURI(env.GraphEndpoint, tenantID),
@@ -283,6 +285,7 @@ func getClient(env azure.Environment, subscriptionID, tenantID string, armSpt *a
 	c.providersClient.Authorizer = authorizer
 	c.virtualMachinesClient.Authorizer = authorizer
 	c.virtualMachineScaleSetsClient.Authorizer = authorizer
+	c.virtualMachineScaleSetVMsClient.Authorizer = authorizer
 	c.disksClient.Authorizer = authorizer
 
 	c.deploymentsClient.PollingDelay = time.Second * 5
@@ -374,6 +377,7 @@ func (az *AzureClient) AddAcceptLanguages(languages []string) {
 	az.providersClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
 	az.virtualMachinesClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
 	az.virtualMachineScaleSetsClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
+	az.virtualMachineScaleSetVMsClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
 	az.disksClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
 
 	az.applicationsClient.ManagementClient.Client.RequestInspector = az.addAcceptLanguages()
@@ -23,3 +23,16 @@ func (az *AzureClient) DeleteVirtualMachine(resourceGroup, name string, cancel <
 func (az *AzureClient) ListVirtualMachineScaleSets(resourceGroup string) (compute.VirtualMachineScaleSetListResult, error) {
 	return az.virtualMachineScaleSetsClient.List(resourceGroup)
 }
+
+// ListVirtualMachineScaleSetVMs returns (the first page of) the instances of the specified vmss.
+func (az *AzureClient) ListVirtualMachineScaleSetVMs(resourceGroup, virtualMachineScaleSet string) (compute.VirtualMachineScaleSetVMListResult, error) {
+	return az.virtualMachineScaleSetVMsClient.List(resourceGroup, virtualMachineScaleSet, "", "", "")
+}
+
+// UpdateVirtualMachineScaleSetInstances upgrades the specified instances of a vmss to its latest model,
+// which is needed for the changes of vmss with a manual upgrade policy to reach the existing instances.
+func (az *AzureClient) UpdateVirtualMachineScaleSetInstances(resourceGroup, virtualMachineScaleSet string, instanceIDs []string, cancel <-chan struct{}) (<-chan compute.OperationStatusResponse, <-chan error) {
+	return az.virtualMachineScaleSetsClient.UpdateInstances(resourceGroup, virtualMachineScaleSet, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIDs,
+	}, cancel)
+}
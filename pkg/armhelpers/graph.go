@@ -31,6 +31,20 @@ func (az *AzureClient) CreateGraphPrincipal(servicePrincipalCreateParameters gra
 	return az.servicePrincipalsClient.Create(servicePrincipalCreateParameters)
 }
 
+// GetServicePrincipalObjectID looks up the object ID of the service principal registered for the given application (client) ID
+func (az *AzureClient) GetServicePrincipalObjectID(applicationID string) (string, error) {
+	filter := fmt.Sprintf("appId eq '%s'", applicationID)
+	log.Debugf("ad: looking up servicePrincipal with filter=%q", filter)
+	result, err := az.servicePrincipalsClient.List(filter)
+	if err != nil {
+		return "", err
+	}
+	if result.Value == nil || len(*result.Value) == 0 {
+		return "", fmt.Errorf("no service principal found for applicationID %q", applicationID)
+	}
+	return to.String((*result.Value)[0].ObjectID), nil
+}
+
 // CreateRoleAssignment creates a role assignment via the authorization client
 func (az *AzureClient) CreateRoleAssignment(scope string, roleAssignmentName string, parameters authorization.RoleAssignmentCreateParameters) (authorization.RoleAssignment, error) {
 	return az.authorizationClient.Create(scope, roleAssignmentName, parameters)
@@ -44,6 +44,12 @@ type ACSEngineClient interface {
 	// ListVirtualMachineScaleSets lists the vmss resources in the resource group
 	ListVirtualMachineScaleSets(resourceGroup string) (compute.VirtualMachineScaleSetListResult, error)
 
+	// ListVirtualMachineScaleSetVMs lists the instances of the specified vmss
+	ListVirtualMachineScaleSetVMs(resourceGroup, virtualMachineScaleSet string) (compute.VirtualMachineScaleSetVMListResult, error)
+
+	// UpdateVirtualMachineScaleSetInstances upgrades the specified instances of a vmss to its latest model
+	UpdateVirtualMachineScaleSetInstances(resourceGroup, virtualMachineScaleSet string, instanceIDs []string, cancel <-chan struct{}) (<-chan compute.OperationStatusResponse, <-chan error)
+
 	//
 	// STORAGE
 
@@ -67,6 +73,9 @@ type ACSEngineClient interface {
 	CreateGraphPrincipal(servicePrincipalCreateParameters graphrbac.ServicePrincipalCreateParameters) (graphrbac.ServicePrincipal, error)
 	CreateApp(applicationName, applicationURL string) (applicationID, servicePrincipalObjectID, secret string, err error)
 
+	// GetServicePrincipalObjectID looks up the object ID of the service principal for an application ID
+	GetServicePrincipalObjectID(applicationID string) (string, error)
+
 	// RBAC
 	CreateRoleAssignment(scope string, roleAssignmentName string, parameters authorization.RoleAssignmentCreateParameters) (authorization.RoleAssignment, error)
 	CreateRoleAssignmentSimple(applicationID, roleID string) error
@@ -25,7 +25,15 @@ type MockACSEngineClient struct {
 	FailGetStorageClient            bool
 	FailDeleteNetworkInterface      bool
 	FailGetKubernetesClient         bool
+	FailGetServicePrincipalObjectID bool
 	MockKubernetesClient            *MockKubernetesClient
+	VirtualMachines                 *[]compute.VirtualMachine
+	VirtualMachineScaleSets         *[]compute.VirtualMachineScaleSet
+	DeployedTemplates               []map[string]interface{}
+	UpdatedVirtualMachineScaleSets  []string
+	// RoleAssignmentErrors are returned by the successive CreateRoleAssignmentSimple calls
+	RoleAssignmentErrors []error
+	RoleAssignments      []string
 }
 
 //MockStorageClient mock implementation of StorageClient
@@ -121,6 +129,7 @@ func (mc *MockACSEngineClient) DeployTemplate(resourceGroup, name string, templa
 	if mc.FailDeployTemplate {
 		return nil, fmt.Errorf("DeployTemplate failed")
 	}
+	mc.DeployedTemplates = append(mc.DeployedTemplates, template)
 
 	return nil, nil
 }
@@ -139,6 +148,9 @@ func (mc *MockACSEngineClient) ListVirtualMachines(resourceGroup string) (comput
 	if mc.FailListVirtualMachines {
 		return compute.VirtualMachineListResult{}, fmt.Errorf("ListVirtualMachines failed")
 	}
+	if mc.VirtualMachines != nil {
+		return compute.VirtualMachineListResult{Value: mc.VirtualMachines}, nil
+	}
 
 	vm1Name := "k8s-agentpool1-12345678-0"
 
@@ -191,7 +203,30 @@ func (mc *MockACSEngineClient) ListVirtualMachineScaleSets(resourceGroup string)
 		return compute.VirtualMachineScaleSetListResult{}, fmt.Errorf("ListVirtualMachines failed")
 	}
 
-	return compute.VirtualMachineScaleSetListResult{}, nil
+	return compute.VirtualMachineScaleSetListResult{Value: mc.VirtualMachineScaleSets}, nil
+}
+
+//ListVirtualMachineScaleSetVMs mock
+func (mc *MockACSEngineClient) ListVirtualMachineScaleSetVMs(resourceGroup, virtualMachineScaleSet string) (compute.VirtualMachineScaleSetVMListResult, error) {
+	instanceID := "0"
+	return compute.VirtualMachineScaleSetVMListResult{
+		Value: &[]compute.VirtualMachineScaleSetVM{
+			{InstanceID: &instanceID},
+		},
+	}, nil
+}
+
+//UpdateVirtualMachineScaleSetInstances mock
+func (mc *MockACSEngineClient) UpdateVirtualMachineScaleSetInstances(resourceGroup, virtualMachineScaleSet string, instanceIDs []string, cancel <-chan struct{}) (<-chan compute.OperationStatusResponse, <-chan error) {
+	mc.UpdatedVirtualMachineScaleSets = append(mc.UpdatedVirtualMachineScaleSets, virtualMachineScaleSet)
+
+	errChan := make(chan error, 1)
+	respChan := make(chan compute.OperationStatusResponse, 1)
+	errChan <- nil
+	respChan <- compute.OperationStatusResponse{}
+	close(errChan)
+	close(respChan)
+	return respChan, errChan
 }
 
 //GetVirtualMachine mock
@@ -336,6 +371,14 @@ func (mc *MockACSEngineClient) CreateApp(applicationName, applicationURL string)
 	return "app-id", "client-id", "client-secret", nil
 }
 
+// GetServicePrincipalObjectID looks up the object ID of the service principal for an application ID
+func (mc *MockACSEngineClient) GetServicePrincipalObjectID(applicationID string) (string, error) {
+	if mc.FailGetServicePrincipalObjectID {
+		return "", fmt.Errorf("GetServicePrincipalObjectID failed")
+	}
+	return "sp-object-id", nil
+}
+
 // RBAC Mocks
 
 // CreateRoleAssignment creates a role assignment via the authorization client
@@ -345,6 +388,14 @@ func (mc *MockACSEngineClient) CreateRoleAssignment(scope string, roleAssignment
 
 // CreateRoleAssignmentSimple is a wrapper around RoleAssignmentsClient.Create
 func (mc *MockACSEngineClient) CreateRoleAssignmentSimple(applicationID, roleID string) error {
+	if len(mc.RoleAssignmentErrors) > 0 {
+		err := mc.RoleAssignmentErrors[0]
+		mc.RoleAssignmentErrors = mc.RoleAssignmentErrors[1:]
+		if err != nil {
+			return err
+		}
+	}
+	mc.RoleAssignments = append(mc.RoleAssignments, roleID)
 	return nil
 }
 
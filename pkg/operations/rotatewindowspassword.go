@@ -0,0 +1,251 @@
+package operations
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// vmAccessExtensionName is the name of the VMAccessAgent extension that resets the admin password
+	vmAccessExtensionName = "enablevmaccess"
+	// vmAccessAPIVersion is the compute api version used to deploy the VMAccessAgent extension
+	vmAccessAPIVersion = "2017-03-30"
+	// keyVaultSecretAPIVersion is the key vault api version used to deploy a secret
+	keyVaultSecretAPIVersion = "2015-06-01"
+	deploymentSchema         = "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#"
+)
+
+var keyVaultIDRe = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.KeyVault/vaults/([^/]+)$`)
+
+// RotateWindowsPassword resets the admin password of the Windows VMs and VMSS of the cluster
+// with the given name suffix and Windows agent pools, by deploying the VMAccessAgent extension
+// with the new password. The instances of VMSS with a manual upgrade policy are then upgraded
+// to the model with the new extension.
+func RotateWindowsPassword(az armhelpers.ACSEngineClient, logger *log.Entry, resourceGroup, nameSuffix string, poolNames []string, adminUsername, password string) error {
+	logger.Infof("listing VMs in resource group %s", resourceGroup)
+	vmListResult, err := az.ListVirtualMachines(resourceGroup)
+	if err != nil {
+		return err
+	}
+	logger.Infof("listing VMSS in resource group %s", resourceGroup)
+	vmssListResult, err := az.ListVirtualMachineScaleSets(resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	var vms []compute.VirtualMachine
+	if vmListResult.Value != nil {
+		vms = *vmListResult.Value
+	}
+	var scaleSets []compute.VirtualMachineScaleSet
+	if vmssListResult.Value != nil {
+		scaleSets = *vmssListResult.Value
+	}
+	vms, scaleSets = clusterWindowsMachines(vms, scaleSets, nameSuffix, poolNames)
+	if len(vms)+len(scaleSets) == 0 {
+		return fmt.Errorf("no Windows VMs or VMSS of the cluster were found in resource group %s", resourceGroup)
+	}
+
+	template := windowsPasswordTemplate(vms, scaleSets, adminUsername)
+	parameters := map[string]interface{}{
+		"adminPassword":  map[string]interface{}{"value": password},
+		"forceUpdateTag": map[string]interface{}{"value": strconv.FormatInt(time.Now().Unix(), 10)},
+	}
+
+	logger.Infof("resetting the admin password of %d Windows VMs and VMSS in resource group %s", len(vms)+len(scaleSets), resourceGroup)
+	name := fmt.Sprintf("%s-windows-password-%d", resourceGroup, time.Now().Unix())
+	if _, err = az.DeployTemplate(resourceGroup, name, template, parameters, nil); err != nil {
+		return err
+	}
+
+	for _, vmss := range scaleSets {
+		if vmss.UpgradePolicy == nil || vmss.UpgradePolicy.Mode != compute.Manual {
+			continue
+		}
+		if err = updateScaleSetInstances(az, logger, resourceGroup, *vmss.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateScaleSetInstances upgrades every instance of a VMSS to its latest model
+func updateScaleSetInstances(az armhelpers.ACSEngineClient, logger *log.Entry, resourceGroup, name string) error {
+	logger.Infof("listing the instances of VMSS %s", name)
+	vmListResult, err := az.ListVirtualMachineScaleSetVMs(resourceGroup, name)
+	if err != nil {
+		return err
+	}
+	instanceIDs := []string{}
+	if vmListResult.Value != nil {
+		for _, vm := range *vmListResult.Value {
+			if vm.InstanceID != nil {
+				instanceIDs = append(instanceIDs, *vm.InstanceID)
+			}
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	logger.Infof("upgrading %d instances of VMSS %s", len(instanceIDs), name)
+	_, errChan := az.UpdateVirtualMachineScaleSetInstances(resourceGroup, name, instanceIDs, nil)
+	return <-errChan
+}
+
+// clusterWindowsMachines returns the Windows VMs and VMSS belonging to the cluster. As in the
+// upgrade, a machine belongs to the cluster when its name contains the name suffix, or starts
+// with the Windows resource name prefix (the first 5 characters of the suffix); its
+// resourceNameSuffix and poolName tags, when set, must match the cluster too.
+func clusterWindowsMachines(vms []compute.VirtualMachine, scaleSets []compute.VirtualMachineScaleSet, nameSuffix string, poolNames []string) ([]compute.VirtualMachine, []compute.VirtualMachineScaleSet) {
+	clusterVMs := []compute.VirtualMachine{}
+	for _, vm := range vms {
+		if vm.Name == nil || vm.VirtualMachineProperties == nil || vm.StorageProfile == nil || vm.StorageProfile.OsDisk == nil ||
+			vm.StorageProfile.OsDisk.OsType != compute.Windows ||
+			(vm.OsProfile != nil && vm.OsProfile.LinuxConfiguration != nil) {
+			continue
+		}
+		if !belongsToCluster(*vm.Name, vm.Tags, nameSuffix, poolNames) {
+			continue
+		}
+		clusterVMs = append(clusterVMs, vm)
+	}
+
+	clusterScaleSets := []compute.VirtualMachineScaleSet{}
+	for _, vmss := range scaleSets {
+		if vmss.Name == nil || vmss.VirtualMachineScaleSetProperties == nil || vmss.VirtualMachineProfile == nil ||
+			vmss.VirtualMachineProfile.StorageProfile == nil || vmss.VirtualMachineProfile.StorageProfile.OsDisk == nil ||
+			vmss.VirtualMachineProfile.StorageProfile.OsDisk.OsType != compute.Windows ||
+			(vmss.VirtualMachineProfile.OsProfile != nil && vmss.VirtualMachineProfile.OsProfile.LinuxConfiguration != nil) {
+			continue
+		}
+		if !belongsToCluster(*vmss.Name, vmss.Tags, nameSuffix, poolNames) {
+			continue
+		}
+		clusterScaleSets = append(clusterScaleSets, vmss)
+	}
+	return clusterVMs, clusterScaleSets
+}
+
+func belongsToCluster(name string, tags *map[string]*string, nameSuffix string, poolNames []string) bool {
+	if nameSuffix == "" {
+		return false
+	}
+	winResourceNamePrefix := nameSuffix
+	if len(winResourceNamePrefix) > 5 {
+		winResourceNamePrefix = winResourceNamePrefix[:5]
+	}
+
+	if tags != nil {
+		if suffix, ok := (*tags)["resourceNameSuffix"]; ok && suffix != nil &&
+			*suffix != nameSuffix && *suffix != winResourceNamePrefix {
+			return false
+		}
+		if poolName, ok := (*tags)["poolName"]; ok && poolName != nil {
+			found := false
+			for _, p := range poolNames {
+				if strings.EqualFold(p, *poolName) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return strings.Contains(name, nameSuffix) || strings.HasPrefix(name, winResourceNamePrefix)
+}
+
+// windowsPasswordTemplate returns an ARM template deploying the VMAccessAgent extension to
+// the given Windows VMs and VMSS
+func windowsPasswordTemplate(vms []compute.VirtualMachine, scaleSets []compute.VirtualMachineScaleSet, adminUsername string) map[string]interface{} {
+	resources := []interface{}{}
+	for _, vm := range vms {
+		resources = append(resources, vmAccessExtension("Microsoft.Compute/virtualMachines/extensions", *vm.Name, vm.Location, adminUsername))
+	}
+	for _, vmss := range scaleSets {
+		resources = append(resources, vmAccessExtension("Microsoft.Compute/virtualMachineScaleSets/extensions", *vmss.Name, vmss.Location, adminUsername))
+	}
+
+	return map[string]interface{}{
+		"$schema":        deploymentSchema,
+		"contentVersion": "1.0.0.0",
+		"parameters": map[string]interface{}{
+			"adminPassword":  map[string]interface{}{"type": "securestring"},
+			"forceUpdateTag": map[string]interface{}{"type": "string"},
+		},
+		"resources": resources,
+	}
+}
+
+func vmAccessExtension(resourceType, parentName string, location *string, adminUsername string) map[string]interface{} {
+	extension := map[string]interface{}{
+		"type":       resourceType,
+		"name":       fmt.Sprintf("%s/%s", parentName, vmAccessExtensionName),
+		"apiVersion": vmAccessAPIVersion,
+		"properties": map[string]interface{}{
+			"publisher":               "Microsoft.Compute",
+			"type":                    "VMAccessAgent",
+			"typeHandlerVersion":      "2.0",
+			"autoUpgradeMinorVersion": true,
+			"forceUpdateTag":          "[parameters('forceUpdateTag')]",
+			"settings": map[string]interface{}{
+				"UserName": adminUsername,
+			},
+			"protectedSettings": map[string]interface{}{
+				"Password": "[parameters('adminPassword')]",
+			},
+		},
+	}
+	if location != nil {
+		extension["location"] = *location
+	}
+	return extension
+}
+
+// SetKeyVaultSecret stores a secret in the key vault with the given resource ID, and returns
+// the path of the secret in the form accepted by the apimodel for key vault references
+func SetKeyVaultSecret(az armhelpers.ACSEngineClient, logger *log.Entry, vaultID, secretName, value string) (string, error) {
+	parts := keyVaultIDRe.FindStringSubmatch(vaultID)
+	if parts == nil {
+		return "", fmt.Errorf("invalid key vault resource ID %q", vaultID)
+	}
+	resourceGroup, vaultName := parts[1], parts[2]
+
+	template := map[string]interface{}{
+		"$schema":        deploymentSchema,
+		"contentVersion": "1.0.0.0",
+		"parameters": map[string]interface{}{
+			"secretValue": map[string]interface{}{"type": "securestring"},
+		},
+		"resources": []interface{}{
+			map[string]interface{}{
+				"type":       "Microsoft.KeyVault/vaults/secrets",
+				"name":       fmt.Sprintf("%s/%s", vaultName, secretName),
+				"apiVersion": keyVaultSecretAPIVersion,
+				"properties": map[string]interface{}{
+					"value": "[parameters('secretValue')]",
+				},
+			},
+		},
+	}
+	parameters := map[string]interface{}{
+		"secretValue": map[string]interface{}{"value": value},
+	}
+
+	logger.Infof("storing secret %s in key vault %s", secretName, vaultName)
+	name := fmt.Sprintf("%s-%s-%d", vaultName, secretName, time.Now().Unix())
+	if _, err := az.DeployTemplate(resourceGroup, name, template, parameters, nil); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/secrets/%s", vaultID, secretName), nil
+}
@@ -0,0 +1,111 @@
+package operations
+
+import (
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+)
+
+var _ = Describe("Rotate windows password operation tests", func() {
+	var logger *log.Entry
+	BeforeEach(func() {
+		logger = log.NewEntry(log.New())
+	})
+
+	It("Should only deploy the extension to the windows machines of the cluster", func() {
+		linuxName, windowsName, otherName, vmssName, location := "k8s-agentpool1-12345678-0", "12345k8s9000", "87654k8s9000", "swarm-winpool-12345678-vmss", "westus"
+		poolName, otherPoolName := "windowspool", "otherpool"
+		vms := []compute.VirtualMachine{
+			{
+				Name:     &linuxName,
+				Location: &location,
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{OsType: compute.Linux},
+					},
+				},
+			},
+			{
+				Name:     &windowsName,
+				Location: &location,
+				Tags:     &map[string]*string{"poolName": &poolName},
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{OsType: compute.Windows},
+					},
+				},
+			},
+			{
+				Name:     &otherName,
+				Location: &location,
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{OsType: compute.Windows},
+					},
+				},
+			},
+			{
+				Name:     &windowsName,
+				Location: &location,
+				Tags:     &map[string]*string{"poolName": &otherPoolName},
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{OsType: compute.Windows},
+					},
+				},
+			},
+		}
+		scaleSets := []compute.VirtualMachineScaleSet{
+			{
+				Name:     &vmssName,
+				Location: &location,
+				VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+					UpgradePolicy: &compute.UpgradePolicy{Mode: compute.Manual},
+					VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+						StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+							OsDisk: &compute.VirtualMachineScaleSetOSDisk{OsType: compute.Windows},
+						},
+					},
+				},
+			},
+		}
+
+		clusterVMs, clusterScaleSets := clusterWindowsMachines(vms, scaleSets, "12345678", []string{poolName})
+		Expect(clusterVMs).To(HaveLen(1))
+		Expect(clusterScaleSets).To(HaveLen(1))
+
+		template := windowsPasswordTemplate(clusterVMs, clusterScaleSets, "azureuser")
+		resources := template["resources"].([]interface{})
+		Expect(resources[0].(map[string]interface{})["name"]).To(Equal("12345k8s9000/enablevmaccess"))
+		Expect(resources[0].(map[string]interface{})["type"]).To(Equal("Microsoft.Compute/virtualMachines/extensions"))
+		Expect(resources[1].(map[string]interface{})["name"]).To(Equal("swarm-winpool-12345678-vmss/enablevmaccess"))
+		Expect(resources[1].(map[string]interface{})["type"]).To(Equal("Microsoft.Compute/virtualMachineScaleSets/extensions"))
+
+		mockClient := armhelpers.MockACSEngineClient{VirtualMachines: &vms, VirtualMachineScaleSets: &scaleSets}
+		err := RotateWindowsPassword(&mockClient, logger, "rg", "12345678", []string{poolName}, "azureuser", "password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mockClient.DeployedTemplates).To(HaveLen(1))
+		Expect(mockClient.UpdatedVirtualMachineScaleSets).To(Equal([]string{vmssName}))
+	})
+	It("Should return an error when there are no windows machines", func() {
+		mockClient := armhelpers.MockACSEngineClient{}
+		err := RotateWindowsPassword(&mockClient, logger, "rg", "12345678", []string{"windowspool"}, "azureuser", "password")
+		Expect(err).To(HaveOccurred())
+	})
+	It("Should return the key vault secret path", func() {
+		mockClient := armhelpers.MockACSEngineClient{}
+		vaultID := "/subscriptions/SUB_ID/resourceGroups/RG_NAME/providers/Microsoft.KeyVault/vaults/KV_NAME"
+		path, err := SetKeyVaultSecret(&mockClient, logger, vaultID, "windows-admin-password", "password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(vaultID + "/secrets/windows-admin-password"))
+
+		_, err = SetKeyVaultSecret(&mockClient, logger, "not-a-vault", "windows-admin-password", "password")
+		Expect(err).To(HaveOccurred())
+
+		mockClient.FailDeployTemplate = true
+		_, err = SetKeyVaultSecret(&mockClient, logger, vaultID, "windows-admin-password", "password")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,57 @@
+package statestore
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+)
+
+// BlobStore keeps the state as block blobs under a prefix of an Azure storage container,
+// so that it is not tied to the machine that ran acs-engine
+type BlobStore struct {
+	container *storage.Container
+	prefix    string
+}
+
+// NewBlobStore returns a store in the container of the storage account, which is created
+// on the first Save if it does not exist
+func NewBlobStore(accountName, accountKey, containerName, prefix string) (*BlobStore, error) {
+	client, err := storage.NewBasicClient(accountName, accountKey)
+	if err != nil {
+		return nil, err
+	}
+	blobService := client.GetBlobService()
+	return &BlobStore{
+		container: blobService.GetContainerReference(containerName),
+		prefix:    prefix,
+	}, nil
+}
+
+// Save implements Store
+func (b *BlobStore) Save(key string, data []byte) error {
+	if _, err := b.container.CreateIfNotExists(nil); err != nil {
+		return err
+	}
+	blob := b.container.GetBlobReference(path.Join(b.prefix, key))
+	return blob.CreateBlockBlobFromReader(bytes.NewReader(data), nil)
+}
+
+// Load implements Store
+func (b *BlobStore) Load(key string) ([]byte, error) {
+	blob := b.container.GetBlobReference(path.Join(b.prefix, key))
+	exists, err := blob.Exists()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+	r, err := blob.Get(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,87 @@
+package statestore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Filesystem is the filesystem a LocalStore writes through. Its methods have the signatures
+// of afero, so an afero.Afero, e.g. afero.Afero{Fs: afero.NewMemMapFs()}, can be used.
+// Implementations must return an error satisfying os.IsNotExist when reading a missing file.
+type Filesystem interface {
+	// MkdirAll creates a directory and all its missing parents
+	MkdirAll(path string, perm os.FileMode) error
+	// WriteFile writes data to the named file, creating or truncating it
+	WriteFile(filename string, data []byte, perm os.FileMode) error
+	// ReadFile returns the contents of the named file
+	ReadFile(filename string) ([]byte, error)
+}
+
+// OsFs is the Filesystem of the operating system
+type OsFs struct{}
+
+// MkdirAll implements Filesystem
+func (OsFs) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+// WriteFile implements Filesystem
+func (OsFs) WriteFile(filename string, data []byte, perm os.FileMode) error {
+	return ioutil.WriteFile(filename, data, perm)
+}
+
+// ReadFile implements Filesystem
+func (OsFs) ReadFile(filename string) ([]byte, error) {
+	return ioutil.ReadFile(filename)
+}
+
+// MemFs is a Filesystem keeping the files in memory, for tests and for callers that
+// capture the artifacts themselves. Directories are implicit.
+type MemFs struct {
+	sync.RWMutex
+	files map[string][]byte
+}
+
+// NewMemFs returns an empty in memory filesystem
+func NewMemFs() *MemFs {
+	return &MemFs{files: map[string][]byte{}}
+}
+
+// MkdirAll implements Filesystem
+func (m *MemFs) MkdirAll(path string, perm os.FileMode) error {
+	return nil
+}
+
+// WriteFile implements Filesystem
+func (m *MemFs) WriteFile(filename string, data []byte, perm os.FileMode) error {
+	m.Lock()
+	defer m.Unlock()
+	m.files[filepath.Clean(filename)] = append([]byte(nil), data...)
+	return nil
+}
+
+// ReadFile implements Filesystem
+func (m *MemFs) ReadFile(filename string) ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	b, ok := m.files[filepath.Clean(filename)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: filename, Err: os.ErrNotExist}
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// Files returns the names of the files written, sorted
+func (m *MemFs) Files() []string {
+	m.RLock()
+	defer m.RUnlock()
+	names := make([]string, 0, len(m.files))
+	for name := range m.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
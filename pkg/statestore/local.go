@@ -0,0 +1,58 @@
+package statestore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LocalStore keeps the state in a directory of a filesystem, the local one by default
+type LocalStore struct {
+	Dir string
+	// Fs is the filesystem the state is written to; when nil it is the local filesystem
+	Fs Filesystem
+}
+
+// NewLocalStore returns a store rooted at dir, which is created on the first Save
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{Dir: dir}
+}
+
+// NewFilesystemStore returns a store rooted at dir of fs, which is created on the first Save
+func NewFilesystemStore(fs Filesystem, dir string) *LocalStore {
+	return &LocalStore{Dir: dir, Fs: fs}
+}
+
+func (l *LocalStore) fs() Filesystem {
+	if l.Fs == nil {
+		return OsFs{}
+	}
+	return l.Fs
+}
+
+// Save implements Store
+func (l *LocalStore) Save(key string, data []byte) error {
+	p := filepath.Join(l.Dir, filepath.FromSlash(key))
+	dir := filepath.Dir(p)
+	if e := l.fs().MkdirAll(dir, 0700); e != nil {
+		return fmt.Errorf("error creating directory '%s': %s", dir, e.Error())
+	}
+	if err := l.fs().WriteFile(p, data, 0600); err != nil {
+		return err
+	}
+
+	log.Debugf("output: wrote %s", p)
+
+	return nil
+}
+
+// Load implements Store
+func (l *LocalStore) Load(key string) ([]byte, error) {
+	b, err := l.fs().ReadFile(filepath.Join(l.Dir, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return b, err
+}
@@ -0,0 +1,47 @@
+package statestore
+
+import (
+	"path"
+	"sync"
+)
+
+// MemoryStore keeps the state in memory, for tests and for callers that persist it themselves
+type MemoryStore struct {
+	sync.RWMutex
+	files map[string][]byte
+}
+
+// NewMemoryStore returns an empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{files: map[string][]byte{}}
+}
+
+// Save implements Store
+func (m *MemoryStore) Save(key string, data []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	m.files[path.Clean(key)] = append([]byte(nil), data...)
+	return nil
+}
+
+// Load implements Store
+func (m *MemoryStore) Load(key string) ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	b, ok := m.files[path.Clean(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// Keys returns the keys of the stored state
+func (m *MemoryStore) Keys() []string {
+	m.RLock()
+	defer m.RUnlock()
+	keys := make([]string, 0, len(m.files))
+	for k := range m.files {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,123 @@
+// Package statestore persists the state of a cluster - the resolved apimodel, its
+// certificates and the deployment metadata - in a pluggable backend
+package statestore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// the keys of the well known state files
+const (
+	// APIModelKey is the key of the resolved apimodel
+	APIModelKey = "apimodel.json"
+	// TemplateKey is the key of the generated ARM template
+	TemplateKey = "azuredeploy.json"
+	// ParametersKey is the key of the generated ARM template parameters
+	ParametersKey = "azuredeploy.parameters.json"
+	// DeploymentKey is the key of the deployment metadata
+	DeploymentKey = "deployment.json"
+	// SummaryKey is the key of the generation summary
+	SummaryKey = "summary.json"
+)
+
+const (
+	// MemoryScheme selects a MemoryStore in Open
+	MemoryScheme = "memory://"
+	// blobScheme selects a BlobStore in Open, e.g. azblob://account/container/prefix
+	blobScheme = "azblob://"
+	// blobAccountKeyEnv is the environment variable holding the storage account key for Open
+	blobAccountKeyEnv = "AZURE_STORAGE_ACCOUNT_KEY"
+)
+
+// ErrNotFound is returned by Store.Load when the key does not exist
+var ErrNotFound = errors.New("state not found")
+
+// Store persists the state of a single cluster. Keys are slash separated relative paths,
+// e.g. "kubeconfig/kubeconfig.westus.json".
+type Store interface {
+	// Save stores data under key, replacing any existing data
+	Save(key string, data []byte) error
+	// Load returns the data stored under key, or ErrNotFound
+	Load(key string) ([]byte, error)
+}
+
+// Deployment is the metadata of the deployment of a cluster
+type Deployment struct {
+	SubscriptionID string `json:"subscriptionId"`
+	ResourceGroup  string `json:"resourceGroup"`
+	Location       string `json:"location,omitempty"`
+	DeploymentName string `json:"deploymentName"`
+}
+
+// SaveDeployment stores the deployment metadata under DeploymentKey
+func SaveDeployment(s Store, d *Deployment) error {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.Save(DeploymentKey, b)
+}
+
+// LoadDeployment returns the deployment metadata stored under DeploymentKey
+func LoadDeployment(s Store) (*Deployment, error) {
+	b, err := s.Load(DeploymentKey)
+	if err != nil {
+		return nil, err
+	}
+	d := &Deployment{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %s", DeploymentKey, err.Error())
+	}
+	return d, nil
+}
+
+// Open returns the store for uri: "memory://" opens an empty MemoryStore,
+// "azblob://account/container[/prefix]" opens a BlobStore authenticated with the key in
+// $AZURE_STORAGE_ACCOUNT_KEY, and any other value opens a LocalStore rooted at that directory
+func Open(uri string) (Store, error) {
+	switch {
+	case uri == "":
+		return nil, errors.New("state store location is empty")
+	case strings.HasPrefix(uri, MemoryScheme):
+		return NewMemoryStore(), nil
+	case strings.HasPrefix(uri, blobScheme):
+		parts := strings.SplitN(strings.TrimPrefix(uri, blobScheme), "/", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("state store %s must be of the form %saccount/container[/prefix]", uri, blobScheme)
+		}
+		key := os.Getenv(blobAccountKeyEnv)
+		if key == "" {
+			return nil, fmt.Errorf("%s must be set to use state store %s", blobAccountKeyEnv, uri)
+		}
+		prefix := ""
+		if len(parts) == 3 {
+			prefix = parts[2]
+		}
+		return NewBlobStore(parts[0], key, parts[1], prefix)
+	default:
+		return NewLocalStore(uri), nil
+	}
+}
+
+// WithPrefix returns a store that keeps its state under prefix in s
+func WithPrefix(s Store, prefix string) Store {
+	return &prefixStore{store: s, prefix: prefix}
+}
+
+type prefixStore struct {
+	store  Store
+	prefix string
+}
+
+func (p *prefixStore) Save(key string, data []byte) error {
+	return p.store.Save(path.Join(p.prefix, key), data)
+}
+
+func (p *prefixStore) Load(key string) ([]byte, error) {
+	return p.store.Load(path.Join(p.prefix, key))
+}
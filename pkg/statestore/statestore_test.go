@@ -0,0 +1,117 @@
+package statestore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testStore(t *testing.T, s Store) {
+	if _, err := s.Load(APIModelKey); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound loading a missing key, got %v", err)
+	}
+	if err := s.Save(APIModelKey, []byte("apimodel")); err != nil {
+		t.Fatalf("unexpected error saving: %s", err)
+	}
+	if err := s.Save("kubeconfig/kubeconfig.westus.json", []byte("kubeconfig")); err != nil {
+		t.Fatalf("unexpected error saving a nested key: %s", err)
+	}
+	if err := s.Save(APIModelKey, []byte("apimodel2")); err != nil {
+		t.Fatalf("unexpected error overwriting: %s", err)
+	}
+
+	b, err := s.Load(APIModelKey)
+	if err != nil {
+		t.Fatalf("unexpected error loading: %s", err)
+	}
+	if string(b) != "apimodel2" {
+		t.Errorf("expected the overwritten data, got %q", b)
+	}
+	b, err = s.Load("kubeconfig/kubeconfig.westus.json")
+	if err != nil {
+		t.Fatalf("unexpected error loading a nested key: %s", err)
+	}
+	if string(b) != "kubeconfig" {
+		t.Errorf("expected the nested data, got %q", b)
+	}
+
+	d := &Deployment{
+		SubscriptionID: "sub",
+		ResourceGroup:  "rg",
+		Location:       "westus",
+		DeploymentName: "rg-1",
+	}
+	if err = SaveDeployment(s, d); err != nil {
+		t.Fatalf("unexpected error saving the deployment: %s", err)
+	}
+	loaded, err := LoadDeployment(s)
+	if err != nil {
+		t.Fatalf("unexpected error loading the deployment: %s", err)
+	}
+	if *loaded != *d {
+		t.Errorf("expected deployment %+v, got %+v", d, loaded)
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "statestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testStore(t, NewLocalStore(filepath.Join(dir, "cluster")))
+
+	if _, err = os.Stat(filepath.Join(dir, "cluster", "kubeconfig", "kubeconfig.westus.json")); err != nil {
+		t.Errorf("expected the nested key to be written to a subdirectory: %s", err)
+	}
+}
+
+func TestFilesystemStore(t *testing.T) {
+	fs := NewMemFs()
+	testStore(t, NewFilesystemStore(fs, "_output/cluster"))
+
+	if _, err := fs.ReadFile(filepath.Join("_output", "cluster", "kubeconfig", "kubeconfig.westus.json")); err != nil {
+		t.Errorf("expected the nested key to be written to the filesystem: %s", err)
+	}
+	if _, err := os.Stat("_output"); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be written to the local filesystem")
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestWithPrefix(t *testing.T) {
+	m := NewMemoryStore()
+	testStore(t, WithPrefix(m, "Upgrade"))
+
+	if _, err := m.Load("Upgrade/" + APIModelKey); err != nil {
+		t.Errorf("expected the state to be stored under the prefix: %s", err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	if _, err := Open(""); err == nil {
+		t.Errorf("expected an error opening an empty location")
+	}
+	if s, err := Open("memory://"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if _, ok := s.(*MemoryStore); !ok {
+		t.Errorf("expected a MemoryStore, got %T", s)
+	}
+	if s, err := Open("_output/foo"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if l, ok := s.(*LocalStore); !ok || l.Dir != "_output/foo" {
+		t.Errorf("expected a LocalStore in _output/foo, got %#v", s)
+	}
+	if _, err := Open("azblob://account"); err == nil {
+		t.Errorf("expected an error opening a blob store without a container")
+	}
+	os.Unsetenv(blobAccountKeyEnv)
+	if _, err := Open("azblob://account/container"); err == nil {
+		t.Errorf("expected an error opening a blob store without an account key")
+	}
+}